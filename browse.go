@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/theme"
+	"github.com/anthonylangham/tmdr/internal/tui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// runBrowse runs the Terminal App and returns the process exit code
+func runBrowse(shared *sharedFlags, args []string) int {
+	fs := newFlagSet("browse")
+	shared.addSourceFlags(fs)
+	fs.Parse(args)
+
+	repo, code := shared.load()
+	if code != exitOK {
+		return code
+	}
+
+	cfg := shared.config
+	t, ok := theme.Lookup(cfg.Theme)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown theme '%s'. Use one of: %s\n", cfg.Theme, strings.Join(theme.Names, ", "))
+		return exitUsage
+	}
+	tui.UseTheme(t)
+
+	var opts []tea.ProgramOption
+	if cfg.TUI.AltScreen {
+		opts = append(opts, tea.WithAltScreen())
+	}
+	model := tui.NewModel(repo, tui.Options{UpdatePolicy: cfg.Update.Policy})
+	program := tea.NewProgram(model, opts...)
+	if _, err := program.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running TUI: %v\n", err)
+		return exitFailure
+	}
+	return exitOK
+}
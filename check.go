@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/check"
+	"github.com/anthonylangham/tmdr/internal/glossary"
+)
+
+// runCheck reports do-not-use abbreviations in files and folders and returns
+// the process exit code, which is non-zero when any are found
+func runCheck(shared *sharedFlags, args []string) int {
+	fs := newFlagSet("check")
+	ignore := fs.String("ignore", "", "Comma-separated abbreviations not to report, such as OS,AD")
+	extensions := fs.String("ext", "", "Comma-separated file extensions to scan in folders (default: common source and doc files)")
+	shared.addSourceFlags(fs)
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	repo, code := shared.load()
+	if code != exitOK {
+		return code
+	}
+
+	var opts check.Options
+	if *ignore != "" {
+		opts.Ignore = strings.Split(*ignore, ",")
+	}
+	var walkOpts glossary.Options
+	if *extensions != "" {
+		walkOpts.Extensions = strings.Split(*extensions, ",")
+	}
+	checker, err := check.New(repo, opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitFailure
+	}
+
+	count, failed := 0, false
+	report := func(name, text string) {
+		for _, finding := range checker.Text(text) {
+			fmt.Printf("%s:%s\n", name, finding)
+			count++
+		}
+	}
+	for _, path := range fs.Args() {
+		// Folders are scanned the way glossary scans them
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			err := glossary.Walk(path, walkOpts, func(file string, data []byte) error {
+				report(file, string(data))
+				return nil
+			})
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+				failed = true
+			}
+			continue
+		}
+
+		text, err := readInput(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			failed = true
+			continue
+		}
+		name := path
+		if path == "-" {
+			name = "<stdin>"
+		}
+		report(name, text)
+	}
+
+	fmt.Printf("%d do-not-use abbreviation(s) found\n", count)
+	if count > 0 || failed {
+		return exitFailure
+	}
+	return exitOK
+}
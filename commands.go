@@ -0,0 +1,303 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/config"
+	"github.com/anthonylangham/tmdr/internal/output"
+)
+
+// command is a tmdr subcommand, described once for help, the man page and
+// shell completion
+type command struct {
+	name string
+	// synopsis is the usage line after the command name
+	synopsis string
+	// summary is the one-line description in tmdr --help
+	summary string
+	// detail follows the summary in the command's own help and the man page
+	detail string
+	// args is what the command's arguments are, for shell completion
+	args   argKind
+	hidden bool
+	run    func(shared *sharedFlags, args []string) int
+}
+
+// argKind is what a command takes as arguments
+type argKind int
+
+const (
+	argNone argKind = iota
+	argAcronyms
+	argFiles
+	argShells
+	argCommands
+	argSettings
+)
+
+// commands lists every subcommand in the order tmdr --help shows them. It is
+// filled in by init because help refers back to it.
+var commands []command
+
+func init() {
+	commands = []command{
+		{
+			name:     "lookup",
+			synopsis: "[--context \"<sentence>\"] <acronym>...",
+			summary:  "Look up acronyms, ranking meanings by the words around them",
+			detail:   "Reads newline-separated acronyms from stdin for -. Also runs for tmdr <acronym>.",
+			args:     argAcronyms,
+			run:      runLookup,
+		},
+		{
+			name:     "search",
+			synopsis: "[--limit n] <words>...",
+			summary:  "Search full forms and definitions by keyword",
+			run:      runSearch,
+		},
+		{
+			name:     "reverse",
+			synopsis: "[--limit n] <phrase>",
+			summary:  "Find the acronym for a full form",
+			detail:   "Word order and small typos are tolerated.",
+			run:      runReverse,
+		},
+		{
+			name:    "random",
+			summary: "Show a random acronym",
+			run:     runRandom,
+		},
+		{
+			name:    "browse",
+			summary: "Launch the Terminal App",
+			detail:  "Also runs when tmdr is given no arguments.",
+			run:     runBrowse,
+		},
+		{
+			name:     "expand",
+			synopsis: "[--style inline|footnote|glossary] [file...]",
+			summary:  "Annotate acronyms in text from files or stdin",
+			detail:   "Reads stdin when no file (or -) is given.",
+			args:     argFiles,
+			run:      runExpand,
+		},
+		{
+			name:     "glossary",
+			synopsis: "[--format markdown|html|json] [-o file] [--ext go,md] <path>",
+			summary:  "Generate a glossary of acronyms used in a project",
+			args:     argFiles,
+			run:      runGlossary,
+		},
+		{
+			name:     "check",
+			synopsis: "[--ignore OS,AD] [--ext md,txt] <file|folder>...",
+			summary:  "Find do-not-use abbreviations such as U, QD and MSO4",
+			detail:   "Reads stdin for -. Folders are scanned like glossary scans them, skipping hidden and dependency folders.",
+			args:     argFiles,
+			run:      runCheck,
+		},
+		{
+			name:     "lint",
+			synopsis: "[--strict] [--max-definition N] <file>...",
+			summary:  "Check dictionary files for mistakes",
+			args:     argFiles,
+			run:      runLint,
+		},
+		{
+			name:     "serve",
+			synopsis: "[--addr host:port] [--cors origin,...]",
+			summary:  "Serve lookups as a local JSON API",
+			detail:   "Endpoints: GET /lookup/{acronym}, /fuzzy?q=, /search?q=, /random, /all?page=; POST /expand",
+			run:      runServe,
+		},
+		{
+			name:     "lsp",
+			synopsis: "[--ignore abbr,...]",
+			summary:  "Run as a language server for VS Code, Neovim and others",
+			detail:   "Run by an editor; speaks the Language Server Protocol over stdio.",
+			run:      runLSP,
+		},
+		{
+			name:    "mcp",
+			summary: "Run as an MCP tool server for AI assistants",
+			detail:  "Run by an AI assistant; serves the lookup, fuzzy, search and expand tools over stdio.",
+			run:     runMCP,
+		},
+		{
+			name:     "update",
+			synopsis: "[--check]",
+			summary:  "Install the latest release",
+			run:      runUpdate,
+		},
+		{
+			name:     "config",
+			synopsis: "get <key> | set <key> <value>... | list | path",
+			summary:  "Show or change settings",
+			detail:   "Settings are kept in $XDG_CONFIG_HOME/tmdr/config.yaml, and TMDR_* environment variables override them.",
+			args:     argSettings,
+			run:      runConfig,
+		},
+		{
+			name:    "version",
+			summary: "Show version information",
+			run:     runVersion,
+		},
+		{
+			name:     "completion",
+			synopsis: "bash|zsh|fish|powershell",
+			summary:  "Print a shell completion script",
+			detail:   "Acronyms are completed from the dictionary, including your own.",
+			args:     argShells,
+			run:      runCompletion,
+		},
+		{
+			name:    "man",
+			summary: "Print the manual page",
+			run:     runMan,
+		},
+		{
+			name:     "help",
+			synopsis: "[command]",
+			summary:  "Show help for tmdr or a command",
+			args:     argCommands,
+			run:      runHelp,
+		},
+		{
+			name:     completeCommand,
+			synopsis: "<index> <word>...",
+			hidden:   true,
+			run:      runComplete,
+		},
+	}
+}
+
+// findCommand returns the subcommand called name
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+// commandNames returns the names of the commands help lists
+func commandNames() []string {
+	var names []string
+	for _, cmd := range commands {
+		if !cmd.hidden {
+			names = append(names, cmd.name)
+		}
+	}
+	return names
+}
+
+// newFlagSet returns a flag set for a subcommand whose usage message comes
+// from the command table
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		cmd, _ := findCommand(name)
+		fmt.Fprintln(os.Stderr, strings.TrimSpace("Usage: tmdr "+name+" "+cmd.synopsis))
+		fmt.Fprintln(os.Stderr, cmd.summary+".")
+		if cmd.detail != "" {
+			fmt.Fprintln(os.Stderr, cmd.detail)
+		}
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// runHelp prints the help for tmdr, or the usage of one command
+func runHelp(shared *sharedFlags, args []string) int {
+	fs := newFlagSet("help")
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		printHelp()
+		return exitOK
+	}
+	cmd, ok := findCommand(fs.Arg(0))
+	if !ok || cmd.hidden {
+		fmt.Fprintf(os.Stderr, "Unknown command '%s'. Run tmdr --help for the list.\n", fs.Arg(0))
+		return exitUsage
+	}
+	// Every command's flag set prints its usage and exits for --help
+	return cmd.run(shared, []string{"--help"})
+}
+
+// sharedFlags are the flags several commands take. They can be given before
+// the command, as in tmdr --format json search, or after it.
+type sharedFlags struct {
+	format    string
+	template  string
+	scorer    string
+	specialty string
+	// config holds the settings the flags don't override
+	config *config.Config
+}
+
+// newSharedFlags returns flags that default to the configured settings
+func newSharedFlags(cfg *config.Config) *sharedFlags {
+	return &sharedFlags{format: cfg.Format, scorer: cfg.Fuzzy.Scorer, config: cfg}
+}
+
+// addOutputFlags adds the flags that choose how results are printed
+func (f *sharedFlags) addOutputFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.format, "format", f.format, "Output `format`: "+strings.Join(output.Formats, ", "))
+	fs.StringVar(&f.template, "template", f.template, "Render each result with a Go text/`template`")
+}
+
+// addDictionaryFlags adds the flags that choose how the dictionary is
+// searched, for commands that suggest corrections for misspellings
+func (f *sharedFlags) addDictionaryFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.scorer, "scorer", f.scorer, "Rank suggestions with this `scorer`: "+strings.Join(acronym.ScorerNames, ", "))
+	f.addSourceFlags(fs)
+}
+
+// addSourceFlags adds the flags that choose which dictionary entries are used,
+// for commands that only match acronyms exactly
+func (f *sharedFlags) addSourceFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.specialty, "specialty", f.specialty, "Only show meanings from this `specialty`, such as cardiology")
+}
+
+// printer returns the printer for the chosen format, or the exit code to stop
+// with when the format is unknown
+func (f *sharedFlags) printer() (printer, int) {
+	if !output.IsValidFormat(f.format) {
+		fmt.Fprintf(os.Stderr, "Unknown format '%s'. Use one of: %s\n", f.format, strings.Join(output.Formats, ", "))
+		return printer{}, exitUsage
+	}
+	return printer{format: f.format, template: f.template}, exitOK
+}
+
+// load opens the embedded acronyms merged with any configured, user and
+// repo-local dictionaries, or returns the exit code to stop with
+func (f *sharedFlags) load() (acronym.Repository, int) {
+	scorer, err := acronym.ScorerByName(f.scorer)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return nil, exitUsage
+	}
+
+	overlay, err := acronym.NewOverlayRepository(f.config.Dictionaries...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading acronym database: %v\n", err)
+		return nil, exitLoadFailed
+	}
+	overlay.SetScorer(scorer)
+
+	if f.specialty == "" {
+		return overlay, exitOK
+	}
+	filtered := acronym.NewSpecialtyRepository(overlay, f.specialty)
+	if all, _ := filtered.All(); len(all) == 0 {
+		fmt.Fprintf(os.Stderr, "Unknown specialty '%s'. Use one of: %s\n", f.specialty, strings.Join(acronym.SpecialtyNames(), ", "))
+		return nil, exitUsage
+	}
+	return filtered, exitOK
+}
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/config"
+	"github.com/anthonylangham/tmdr/internal/expand"
+	"github.com/anthonylangham/tmdr/internal/glossary"
+	"github.com/anthonylangham/tmdr/internal/output"
+)
+
+// completeCommand is the hidden command the completion scripts call for
+// candidates, so the scripts stay thin and every shell completes the same way
+const completeCommand = "__complete"
+
+// completionScripts maps each supported shell to its completion script
+var completionScripts = map[string]string{
+	"bash":       bashCompletion,
+	"zsh":        zshCompletion,
+	"fish":       fishCompletion,
+	"powershell": powershellCompletion,
+}
+
+// shells lists the shells completion supports, in the order help shows them
+var shells = []string{"bash", "zsh", "fish", "powershell"}
+
+// runCompletion prints the completion script for a shell and returns the
+// process exit code
+func runCompletion(_ *sharedFlags, args []string) int {
+	fs := newFlagSet("completion")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return exitUsage
+	}
+	script, ok := completionScripts[fs.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown shell '%s'. Use one of: %s\n", fs.Arg(0), strings.Join(shells, ", "))
+		return exitUsage
+	}
+	fmt.Print(script)
+	return exitOK
+}
+
+// runComplete prints the candidates for one word of a tmdr command line, one
+// per line. The first argument is the index of that word, counting tmdr as 0,
+// and the rest are the words after tmdr. Printing nothing lets the shell fall
+// back to file names.
+func runComplete(_ *sharedFlags, args []string) int {
+	// No flag set: the words being completed are often flags themselves
+	if len(args) == 0 {
+		return exitUsage
+	}
+	index, err := strconv.Atoi(args[0])
+	if err != nil || index < 1 {
+		return exitUsage
+	}
+
+	words := args[1:]
+	var current string
+	if index <= len(words) {
+		current = words[index-1]
+		words = words[:index-1]
+	}
+
+	for _, candidate := range completions(words, current) {
+		fmt.Println(candidate)
+	}
+	return exitOK
+}
+
+// completions returns the candidates for current given the words before it
+func completions(words []string, current string) []string {
+	cmd, found := commandIn(words)
+
+	// The value of a flag, given as --flag value or --flag=value
+	if n := len(words); n > 0 {
+		if values, ok := flagValues(cmd, words[n-1]); ok {
+			return matching(values, current)
+		}
+	}
+	if name, value, ok := strings.Cut(current, "="); ok && strings.HasPrefix(name, "-") {
+		values, _ := flagValues(cmd, name)
+		candidates := matching(values, value)
+		for i, candidate := range candidates {
+			candidates[i] = name + "=" + candidate
+		}
+		return candidates
+	}
+
+	if strings.HasPrefix(current, "-") {
+		return matching(flagNames(cmd, found), current)
+	}
+
+	if !found {
+		// tmdr <acronym> looks the acronym up, so offer both, but only list
+		// every acronym once there is a prefix to narrow them
+		candidates := matching(commandNames(), current)
+		if current != "" {
+			candidates = append(candidates, acronymsWithPrefix(words, current)...)
+		}
+		return candidates
+	}
+
+	switch cmd.args {
+	case argAcronyms:
+		return acronymsWithPrefix(words, current)
+	case argShells:
+		return matching(shells, current)
+	case argCommands:
+		return matching(commandNames(), current)
+	case argSettings:
+		return settingCompletions(words, current)
+	}
+	return nil
+}
+
+// commandIn returns the command a command line runs, reporting false while
+// the command hasn't been typed yet. A line starting with an acronym runs
+// lookup.
+func commandIn(words []string) (command, bool) {
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if strings.HasPrefix(word, "-") && word != "-" {
+			// Skip the value of a shared flag given as --flag value
+			if _, ok := flagValues(command{}, word); ok && !strings.Contains(word, "=") {
+				i++
+			}
+			continue
+		}
+		if cmd, ok := findCommand(word); ok {
+			return cmd, true
+		}
+		lookup, _ := findCommand("lookup")
+		return lookup, true
+	}
+	return command{}, false
+}
+
+// settingCompletions completes tmdr config: the action, then the key, then
+// the values the key can take
+func settingCompletions(words []string, current string) []string {
+	var args []string
+	for i, word := range words {
+		if word == "config" {
+			args = words[i+1:]
+			break
+		}
+	}
+
+	switch {
+	case len(args) == 0:
+		return matching(configActions, current)
+	case len(args) == 1 && (args[0] == "get" || args[0] == "set"):
+		names := make([]string, len(config.Keys))
+		for i, k := range config.Keys {
+			names[i] = k.Name
+		}
+		return matching(names, current)
+	case len(args) == 2 && args[0] == "set":
+		if k, ok := config.LookupKey(args[1]); ok {
+			return matching(k.Values, current)
+		}
+	}
+	return nil
+}
+
+// sharedFlagNames are the flags tmdr takes before a command
+var sharedFlagNames = []string{"--format", "--template", "--scorer", "--specialty", "--help", "--version"}
+
+// synopsisFlag matches the flags a command's synopsis lists
+var synopsisFlag = regexp.MustCompile(`--?[a-z][a-z-]*`)
+
+// flagNames returns the flags to offer for a command, or tmdr's own flags
+// before one is typed
+func flagNames(cmd command, found bool) []string {
+	if !found {
+		return sharedFlagNames
+	}
+	names := synopsisFlag.FindAllString(cmd.synopsis, -1)
+	return append(names, "--help")
+}
+
+// flagValues returns the values a flag can take, reporting false for flags
+// without a fixed set of values or without a value at all
+func flagValues(cmd command, flagName string) ([]string, bool) {
+	name, _, _ := strings.Cut(strings.TrimLeft(flagName, "-"), "=")
+	switch name {
+	case "format":
+		if cmd.name == "glossary" {
+			return []string{glossary.FormatMarkdown, glossary.FormatHTML, glossary.FormatJSON}, true
+		}
+		return output.Formats, true
+	case "scorer":
+		return acronym.ScorerNames, true
+	case "specialty":
+		return acronym.SpecialtyNames(), true
+	case "style":
+		styles := make([]string, len(expand.Styles))
+		for i, style := range expand.Styles {
+			styles[i] = string(style)
+		}
+		return styles, true
+	case "template":
+		return nil, true
+	}
+	return nil, false
+}
+
+// acronymsWithPrefix returns the dictionary's acronyms that start with
+// prefix, ignoring case, from the specialty the command line chose if any
+func acronymsWithPrefix(words []string, prefix string) []string {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil
+	}
+	shared := newSharedFlags(cfg)
+	for i, word := range words {
+		name, value, hasValue := strings.Cut(strings.TrimLeft(word, "-"), "=")
+		if !strings.HasPrefix(word, "-") || name != "specialty" {
+			continue
+		}
+		if !hasValue && i+1 < len(words) {
+			value = words[i+1]
+		}
+		shared.specialty = value
+	}
+
+	repo, code := shared.load()
+	if code != exitOK {
+		return nil
+	}
+	all, err := repo.All()
+	if err != nil {
+		return nil
+	}
+
+	prefix = strings.ToUpper(prefix)
+	var names []string
+	for _, a := range all {
+		if strings.HasPrefix(strings.ToUpper(a.Acronym), prefix) {
+			names = append(names, a.Acronym)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// matching returns the candidates that start with prefix
+func matching(candidates []string, prefix string) []string {
+	var matches []string
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, prefix) {
+			matches = append(matches, candidate)
+		}
+	}
+	return matches
+}
+
+const bashCompletion = `# bash completion for tmdr
+# Load it from ~/.bashrc with: source <(tmdr completion bash)
+
+_tmdr() {
+    local IFS=$'\n'
+    COMPREPLY=($(tmdr __complete "$COMP_CWORD" "${COMP_WORDS[@]:1}" 2>/dev/null))
+}
+
+complete -o default -F _tmdr tmdr
+`
+
+const zshCompletion = `#compdef tmdr
+# zsh completion for tmdr
+# Load it from ~/.zshrc with: source <(tmdr completion zsh)
+# or save it as _tmdr in a folder on $fpath
+
+_tmdr() {
+    local -a candidates
+    candidates=(${(f)"$(tmdr __complete $((CURRENT - 1)) "${(@)words[2,-1]}" 2>/dev/null)"})
+    if (( ${#candidates} )); then
+        compadd -U -- "${candidates[@]}"
+    else
+        _files
+    fi
+}
+
+if [[ "${funcstack[1]}" == "_tmdr" ]]; then
+    _tmdr "$@"
+else
+    compdef _tmdr tmdr
+fi
+`
+
+const fishCompletion = `# fish completion for tmdr
+# Save it with: tmdr completion fish > ~/.config/fish/completions/tmdr.fish
+
+function __tmdr_complete
+    set -l words (commandline -opc)
+    set -e words[1]
+    set -l current (commandline -ct)
+    set -l candidates (tmdr __complete (math (count $words) + 1) $words $current 2>/dev/null)
+    if test (count $candidates) -eq 0
+        __fish_complete_path $current
+        return
+    end
+    printf '%s\n' $candidates
+end
+
+complete -c tmdr -f -a '(__tmdr_complete)'
+`
+
+const powershellCompletion = `# PowerShell completion for tmdr
+# Load it from your profile with:
+#   tmdr completion powershell | Out-String | Invoke-Expression
+
+Register-ArgumentCompleter -Native -CommandName tmdr -ScriptBlock {
+    param($wordToComplete, $commandAst, $cursorPosition)
+
+    $words = @($commandAst.CommandElements |
+        Select-Object -Skip 1 |
+        Where-Object { $_.Extent.StartOffset -lt $cursorPosition } |
+        ForEach-Object { $_.ToString() })
+    $index = $words.Count
+    if ($wordToComplete -eq '') {
+        $index++
+    }
+
+    # Returning nothing lets PowerShell complete file names instead
+    & tmdr __complete $index @words 2>$null | ForEach-Object {
+        [System.Management.Automation.CompletionResult]::new($_, $_, 'ParameterValue', $_)
+    }
+}
+`
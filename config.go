@@ -0,0 +1,113 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/config"
+)
+
+// configActions are what tmdr config can do, in the order help shows them
+var configActions = []string{"get", "set", "list", "path"}
+
+// runConfig shows or changes settings and returns the process exit code
+func runConfig(_ *sharedFlags, args []string) int {
+	fs := newFlagSet("config")
+	fs.Parse(args)
+
+	action, rest := fs.Arg(0), fs.Args()
+	if len(rest) > 0 {
+		rest = rest[1:]
+	}
+
+	switch {
+	case action == "path" && len(rest) == 0:
+		fmt.Println(config.Path())
+		return exitOK
+	case action == "list" && len(rest) == 0:
+		return listSettings()
+	case action == "get" && len(rest) == 1:
+		return getSetting(rest[0])
+	case action == "set" && len(rest) >= 1:
+		return setSetting(rest[0], rest[1:])
+	}
+	fs.Usage()
+	return exitUsage
+}
+
+// listSettings prints every setting as key = value, noting the ones the
+// environment overrides
+func listSettings() int {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error in config: %v\n", err)
+		return exitUsage
+	}
+
+	width := 0
+	for _, k := range config.Keys {
+		width = max(width, len(k.Name))
+	}
+	for _, k := range config.Keys {
+		fmt.Printf("%-*s = %s", width, k.Name, strings.Join(k.Get(cfg), string(filepath.ListSeparator)))
+		if _, ok := os.LookupEnv(k.Env()); ok {
+			fmt.Printf("  (from %s)", k.Env())
+		}
+		fmt.Println()
+	}
+	return exitOK
+}
+
+// getSetting prints one setting, a list one item per line
+func getSetting(name string) int {
+	k, ok := config.LookupKey(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown setting '%s'. Run tmdr config list for the list.\n", name)
+		return exitUsage
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error in config: %v\n", err)
+		return exitUsage
+	}
+	for _, value := range k.Get(cfg) {
+		fmt.Println(value)
+	}
+	return exitOK
+}
+
+// setSetting writes one setting to the config file
+func setSetting(name string, values []string) int {
+	k, ok := config.LookupKey(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown setting '%s'. Run tmdr config list for the list.\n", name)
+		return exitUsage
+	}
+	// Dictionaries given on the command line are relative to where it runs,
+	// not to the config file
+	if k.IsList() {
+		for i, value := range values {
+			if strings.HasPrefix(value, "~") {
+				continue
+			}
+			if abs, err := filepath.Abs(value); err == nil {
+				values[i] = abs
+			}
+		}
+	}
+
+	if err := config.Set(config.Path(), name, values...); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if errors.Is(err, config.ErrInvalidValue) {
+			return exitUsage
+		}
+		return exitFailure
+	}
+	if _, ok := os.LookupEnv(k.Env()); ok {
+		fmt.Fprintf(os.Stderr, "Note: %s is set, so it overrides %s.\n", k.Env(), name)
+	}
+	return exitOK
+}
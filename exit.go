@@ -0,0 +1,39 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+)
+
+// Exit codes, so scripts can tell why tmdr stopped
+const (
+	exitOK = 0
+	// exitFailure covers problems found by check and lint, and errors
+	// without a more specific code
+	exitFailure = 1
+	// exitUsage means the flags or arguments were invalid, as the flag
+	// package reports
+	exitUsage = 2
+	// exitNotFound means a lookup, search or reverse lookup found nothing
+	exitNotFound = 3
+	// exitLoadFailed means a dictionary could not be loaded
+	exitLoadFailed = 4
+)
+
+// exitCode returns the exit code for an error
+func exitCode(err error) int {
+	var parseErr *acronym.ParseError
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, acronym.ErrNotFound),
+		errors.Is(err, acronym.ErrNoFuzzyMatch),
+		errors.Is(err, acronym.ErrEmptyRepository):
+		return exitNotFound
+	case errors.As(err, &parseErr):
+		return exitLoadFailed
+	default:
+		return exitFailure
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/config"
+)
+
+// sandbox runs a test away from the user's config and dictionaries and
+// returns a folder for its files
+func sandbox(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(dir, "config"))
+	t.Chdir(dir)
+
+	// Commands print their reports to stdout, which the test doesn't need
+	null, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout, stderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = null, null
+	t.Cleanup(func() {
+		os.Stdout, os.Stderr = stdout, stderr
+		null.Close()
+	})
+	return dir
+}
+
+func writeFile(t *testing.T, dir, name, data string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestCheckExitCodes(t *testing.T) {
+	dir := sandbox(t)
+	clean := writeFile(t, dir, "clean.txt", "MS with MRI lesions.\nUSE AS DIRECTED\n")
+	unsafe := writeFile(t, dir, "unsafe.txt", "Give MS 5 mg IV. MS with MRI lesions.\n")
+
+	tests := []struct {
+		args []string
+		want int
+	}{
+		{[]string{clean}, exitOK},
+		{[]string{unsafe}, exitFailure},
+		{[]string{clean, unsafe}, exitFailure},
+		{[]string{"--ignore", "MS", unsafe}, exitOK},
+		{[]string{filepath.Join(dir, "missing.txt")}, exitFailure},
+		{[]string{"--specialty", "astrology", clean}, exitUsage},
+		{nil, exitUsage},
+	}
+	for _, tt := range tests {
+		shared := newSharedFlags(config.Default())
+		if got := runCheck(shared, tt.args); got != tt.want {
+			t.Errorf("check %v exited %d, want %d", tt.args, got, tt.want)
+		}
+	}
+
+	// A broken dictionary in the user dictionary folder stops every command
+	dicts := filepath.Join(dir, "config", "tmdr", "dicts")
+	if err := os.MkdirAll(dicts, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, dicts, "broken.csv", "acronym,definition\n\"ABG\n")
+	if got := runCheck(newSharedFlags(config.Default()), []string{clean}); got != exitLoadFailed {
+		t.Errorf("check with a broken dictionary exited %d, want %d", got, exitLoadFailed)
+	}
+}
+
+func TestLintExitCodes(t *testing.T) {
+	dir := sandbox(t)
+	clean := writeFile(t, dir, "clean.csv", "acronym,definition\nABG,Arterial Blood Gas – A blood test\n")
+	warning := writeFile(t, dir, "warning.csv", "acronym,definition\nABG ,Arterial Blood Gas – A blood test\n")
+	broken := writeFile(t, dir, "broken.csv", "acronym,definition\nABG,Arterial Blood Gas - A blood test\n")
+
+	tests := []struct {
+		args []string
+		want int
+	}{
+		{[]string{clean}, exitOK},
+		{[]string{warning}, exitOK},
+		{[]string{"--strict", warning}, exitFailure},
+		{[]string{broken}, exitFailure},
+		{[]string{clean, broken}, exitFailure},
+		{[]string{filepath.Join(dir, "dict.txt")}, exitFailure},
+		{nil, exitUsage},
+	}
+	for _, tt := range tests {
+		if got := runLint(newSharedFlags(config.Default()), tt.args); got != tt.want {
+			t.Errorf("lint %v exited %d, want %d", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{nil, exitOK},
+		{acronym.ErrNotFound, exitNotFound},
+		{fmt.Errorf("lookup: %w", acronym.ErrNoFuzzyMatch), exitNotFound},
+		{acronym.ErrEmptyRepository, exitNotFound},
+		{fmt.Errorf("failed to load: %w", &acronym.ParseError{Path: "dict.csv", Line: 2, Err: errors.New("bad row")}), exitLoadFailed},
+		{errors.New("something else"), exitFailure},
+	}
+	for _, tt := range tests {
+		if got := exitCode(tt.err); got != tt.want {
+			t.Errorf("exitCode(%v) = %d, want %d", tt.err, got, tt.want)
+		}
+	}
+}
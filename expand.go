@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/anthonylangham/tmdr/internal/expand"
+)
+
+// runExpand annotates acronyms in files or stdin and returns the process exit code
+func runExpand(shared *sharedFlags, args []string) int {
+	fs := newFlagSet("expand")
+	style := fs.String("style", string(expand.StyleInline), "Annotation style: inline, footnote or glossary")
+	shared.addSourceFlags(fs)
+	fs.Parse(args)
+
+	repo, code := shared.load()
+	if code != exitOK {
+		return code
+	}
+
+	paths := fs.Args()
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	opts := expand.Options{Style: expand.Style(*style)}
+	for _, path := range paths {
+		text, err := readInput(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", path, err)
+			return exitFailure
+		}
+
+		expanded, err := expand.Expand(text, repo, opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitUsage
+		}
+		fmt.Print(expanded)
+	}
+	return exitOK
+}
+
+// readInput reads a whole file, or stdin for "-"
+func readInput(path string) (string, error) {
+	if path == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		return string(data), err
+	}
+	data, err := os.ReadFile(path)
+	return string(data), err
+}
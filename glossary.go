@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/glossary"
+)
+
+// runGlossary writes a glossary of the acronyms used under a directory and
+// returns the process exit code
+func runGlossary(shared *sharedFlags, args []string) int {
+	fs := newFlagSet("glossary")
+	format := fs.String("format", "", "Glossary format: markdown, html or json (default from --output, else markdown)")
+	outputPath := fs.String("output", "", "Write the glossary to this file instead of stdout")
+	fs.StringVar(outputPath, "o", "", "Shorthand for --output")
+	extensions := fs.String("ext", "", "Comma-separated file extensions to scan (default: common source and doc files)")
+	shared.addSourceFlags(fs)
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	repo, code := shared.load()
+	if code != exitOK {
+		return code
+	}
+
+	var opts glossary.Options
+	if *extensions != "" {
+		opts.Extensions = strings.Split(*extensions, ",")
+	}
+
+	// Don't count the glossary we're about to regenerate
+	if *outputPath != "" {
+		opts.Exclude = []string{*outputPath}
+	}
+
+	entries, err := glossary.Build(fs.Arg(0), repo, opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error scanning %s: %v\n", fs.Arg(0), err)
+		return exitFailure
+	}
+
+	if *format == "" {
+		*format = glossary.FormatForPath(*outputPath)
+	}
+
+	var w io.Writer = os.Stdout
+	if *outputPath != "" {
+		file, err := os.Create(*outputPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", *outputPath, err)
+			return exitFailure
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if err := glossary.Write(w, *format, entries); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing glossary: %v\n", err)
+		return exitFailure
+	}
+	if *outputPath != "" {
+		fmt.Fprintf(os.Stderr, "Wrote %d terms to %s\n", len(entries), *outputPath)
+	}
+	return exitOK
+}
@@ -1,16 +1,64 @@
 package acronym
 
-// Acronym represents a medical acronym with its full form and definition
+// Acronym represents a medical acronym with every sense it can stand for.
+// Senses are ordered by rank, most common meaning first.
 type Acronym struct {
-	Acronym    string
+	Acronym string
+	Senses  []Sense
+}
+
+// Sense represents a single meaning of an acronym with its full form and definition
+type Sense struct {
 	FullForm   string
 	Definition string
+	Specialty  string
+	Synonyms   []string
+	References []string
+	// Context lists cue words or phrases that suggest this sense when they
+	// appear near the acronym
+	Context []string
+	// DoNotUse marks a sense on a patient safety do-not-use list, which
+	// should not appear in clinical documents or user interfaces
+	DoNotUse bool
+	// Alternative is what to write instead of a do-not-use abbreviation
+	Alternative string
+	// Source names the dictionary the sense was loaded from
+	Source string
+	// Layer names the CompositeRepository layer the sense came from
+	Layer string
+}
+
+// Primary returns the highest ranked sense of the acronym
+func (a Acronym) Primary() Sense {
+	if len(a.Senses) == 0 {
+		return Sense{}
+	}
+	return a.Senses[0]
+}
+
+// MatchType describes how a lookup found an acronym
+type MatchType string
+
+const (
+	MatchExact  MatchType = "exact"
+	MatchFuzzy  MatchType = "fuzzy"
+	MatchRandom MatchType = "random"
+	MatchSearch MatchType = "search"
+)
+
+// Match is an acronym returned by a lookup along with how well it matched.
+// Score ranges from 0 to 1, where 1 is an exact match.
+type Match struct {
+	Acronym Acronym
+	Type    MatchType
+	Score   float64
 }
 
 // Repository defines the interface for acronym storage
 type Repository interface {
 	Find(acronym string) (*Acronym, error)
-	FindFuzzy(acronym string, maxResults int) ([]Acronym, error)
+	FindFuzzy(acronym string, maxResults int) ([]Match, error)
+	Search(query string, opts SearchOptions) ([]Match, error)
 	Random() (*Acronym, error)
 	All() ([]Acronym, error)
-}
\ No newline at end of file
+}
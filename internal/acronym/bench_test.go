@@ -0,0 +1,171 @@
+package acronym
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Benchmarks measure how dictionary loading and lookups scale on a large
+// synthetic dictionary, such as an institutional abbreviation list:
+//
+//	go test -run '^$' -bench . -benchmem ./internal/acronym -args -entries 100000
+var benchEntries = flag.Int("entries", 100000, "Number of synthetic acronyms in benchmark dictionaries")
+
+// benchWords make up synthetic full forms
+var benchWords = strings.Fields(`acute arterial blood brain cardiac chronic coronary disease
+	distress embolism failure function gas heart index infection injury kidney
+	lung mass obstructive pressure pulmonary rate renal respiratory syndrome
+	test therapy thrombosis urinary valve venous ventricular`)
+
+// benchData is a synthetic dictionary with queries to run against it
+type benchData struct {
+	csv   []byte
+	keys  []string
+	typos []string
+}
+
+// benchDictionary is built once and shared by the benchmarks
+var benchDictionary = sync.OnceValue(func() (d benchData) {
+	d.csv, d.keys = synthesize(*benchEntries)
+	d.typos = typos(d.keys, 64)
+	return d
+})
+
+// loadBenchCSV parses the synthetic dictionary the way NewCSVRepository does
+func loadBenchCSV(b *testing.B) *MemoryRepository {
+	repo := newMemoryRepository("bench")
+	if err := repo.loadCSV(bytes.NewReader(benchDictionary().csv)); err != nil {
+		b.Fatal(err)
+	}
+	repo.buildFuzzyIndex()
+	return repo
+}
+
+func BenchmarkLoadEmbedded(b *testing.B) {
+	b.Run("csv", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := NewEmbeddedCSVRepository(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("compiled", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := NewEmbeddedRepository(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkLoad(b *testing.B) {
+	benchDictionary()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		loadBenchCSV(b)
+	}
+}
+
+func BenchmarkFind(b *testing.B) {
+	d := benchDictionary()
+	repo := loadBenchCSV(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		repo.Find(d.keys[i%len(d.keys)])
+	}
+}
+
+func BenchmarkFindFuzzy(b *testing.B) {
+	d := benchDictionary()
+	repo := loadBenchCSV(b)
+	fuzzy := func(scorer Scorer) func(b *testing.B) {
+		return func(b *testing.B) {
+			repo.SetScorer(scorer)
+			for i := 0; i < b.N; i++ {
+				repo.FindFuzzy(d.typos[i%len(d.typos)], 3)
+			}
+		}
+	}
+
+	for _, name := range ScorerNames {
+		scorer, _ := ScorerByName(name)
+		b.Run(name, fuzzy(scorer))
+	}
+	// The same scorer hidden behind a ScorerFunc can't use the key trie, so
+	// it scores every acronym; the difference is the trie's effect
+	b.Run("levenshtein-scan", fuzzy(ScorerFunc(levenshteinScorer{}.Score)))
+}
+
+func BenchmarkSearch(b *testing.B) {
+	benchDictionary()
+	// The search index is built on first use
+	b.Run("first", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			loadBenchCSV(b).Search("warm", SearchOptions{})
+		}
+	})
+	b.Run("warm", func(b *testing.B) {
+		repo := loadBenchCSV(b)
+		repo.Search("warm", SearchOptions{})
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			repo.Search(benchWords[i%len(benchWords)]+" "+benchWords[(i+7)%len(benchWords)], SearchOptions{Limit: 10})
+		}
+	})
+}
+
+// synthesize writes n random acronyms as CSV and returns it with their keys
+func synthesize(n int) ([]byte, []string) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"acronym", "definition"})
+
+	seen := make(map[string]bool, n)
+	keys := make([]string, 0, n)
+	for len(keys) < n {
+		key := randomKey(rng)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+
+		fullForm := make([]string, len(key))
+		for i := range fullForm {
+			fullForm[i] = benchWords[rng.IntN(len(benchWords))]
+		}
+		w.Write([]string{key, strings.Join(fullForm, " ") + " " + DefinitionSeparator + " Synthetic entry"})
+	}
+	w.Flush()
+	return buf.Bytes(), keys
+}
+
+// randomKey returns an acronym of 2 to 7 letters, occasionally with a digit
+func randomKey(rng *rand.Rand) string {
+	key := make([]byte, 2+rng.IntN(6))
+	for i := range key {
+		key[i] = byte('A' + rng.IntN(26))
+		if i > 0 && rng.IntN(10) == 0 {
+			key[i] = byte('0' + rng.IntN(10))
+		}
+	}
+	return string(key)
+}
+
+// typos returns n keys with one letter changed, as a user might mistype them
+func typos(keys []string, n int) []string {
+	rng := rand.New(rand.NewPCG(3, 4))
+	queries := make([]string, n)
+	for i := range queries {
+		key := []byte(keys[rng.IntN(len(keys))])
+		key[rng.IntN(len(key))] = byte('A' + rng.IntN(26))
+		queries[i] = string(key)
+	}
+	return queries
+}
@@ -0,0 +1,48 @@
+// Command compile precompiles a CSV dictionary, with its fuzzy and full-text
+// indices, into the Go tables loaded by acronym.NewEmbeddedRepository. It
+// runs from go generate in the acronym package:
+//
+//	go run -tags tmdr_compile ./compile -o embedded_gen.go data/acronyms.csv
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+)
+
+func main() {
+	output := flag.String("o", "", "Output file")
+	source := flag.String("source", acronym.EmbeddedSource, "Source name recorded on each sense")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: compile -o <output.go> <dictionary.csv>")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *output == "" || flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := acronym.Compile(&buf, data, *source); err != nil {
+		fatal(err)
+	}
+	if err := os.WriteFile(*output, buf.Bytes(), 0644); err != nil {
+		fatal(err)
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "compile: %v\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,237 @@
+package acronym
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+)
+
+// Layer is a named Repository stacked inside a CompositeRepository
+type Layer struct {
+	Name string
+	Repo Repository
+}
+
+// CompositeRepository implements Repository by stacking several repositories.
+// Layers are ordered highest precedence first: their senses rank ahead of
+// lower layers and replace lower senses with the same full form.
+type CompositeRepository struct {
+	layers []Layer
+
+	indexOnce sync.Once
+	index     *searchIndex
+	indexErr  error
+}
+
+// NewCompositeRepository creates a repository over layers, highest precedence first
+func NewCompositeRepository(layers ...Layer) *CompositeRepository {
+	return &CompositeRepository{layers: layers}
+}
+
+// Layers returns the stacked layers, highest precedence first
+func (c *CompositeRepository) Layers() []Layer {
+	return c.layers
+}
+
+// Find looks up an acronym in every layer and merges the senses found
+func (c *CompositeRepository) Find(acronym string) (*Acronym, error) {
+	var result *Acronym
+	for _, layer := range c.layers {
+		a, err := layer.Repo.Find(acronym)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up %s in layer %s: %w", acronym, layer.Name, err)
+		}
+		senses := stampLayer(a.Senses, layer.Name)
+		if result == nil {
+			result = &Acronym{Acronym: a.Acronym, Senses: senses}
+			continue
+		}
+		result.Senses = mergeSenses(result.Senses, senses)
+	}
+
+	if result == nil {
+		return nil, notFound(acronym)
+	}
+	return result, nil
+}
+
+// FindFuzzy collects fuzzy matches from every layer, merges matches for the
+// same acronym and ranks them by their best score, then alphabetically
+func (c *CompositeRepository) FindFuzzy(acronym string, maxResults int) ([]Match, error) {
+	if maxResults <= 0 {
+		maxResults = 3
+	}
+
+	// Keep each acronym's best score across layers
+	var keys []string
+	scores := make(map[string]float64)
+	for _, layer := range c.layers {
+		matches, err := layer.Repo.FindFuzzy(acronym, maxResults)
+		if errors.Is(err, ErrNoFuzzyMatch) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to match %s in layer %s: %w", acronym, layer.Name, err)
+		}
+		for _, m := range matches {
+			key := m.Acronym.Acronym
+			if _, seen := scores[key]; !seen {
+				keys = append(keys, key)
+			}
+			if m.Score > scores[key] {
+				scores[key] = m.Score
+			}
+		}
+	}
+
+	// Look matches up again so they carry senses from every layer
+	var results []Match
+	for _, key := range keys {
+		a, err := c.Find(key)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, Match{Acronym: *a, Type: MatchFuzzy, Score: scores[key]})
+	}
+
+	if len(results) == 0 {
+		return nil, noFuzzyMatch(acronym)
+	}
+
+	sortMatches(results)
+
+	if len(results) > maxResults {
+		results = results[:maxResults]
+	}
+	return results, nil
+}
+
+// SetScorer changes how FindFuzzy ranks candidates in every layer that
+// supports it
+func (c *CompositeRepository) SetScorer(s Scorer) {
+	for _, layer := range c.layers {
+		if r, ok := layer.Repo.(interface{ SetScorer(Scorer) }); ok {
+			r.SetScorer(s)
+		}
+	}
+}
+
+// Search ranks the merged acronyms against a free text query. The index is
+// built over All on first use, so a sense shadowed by a higher layer is
+// never returned.
+func (c *CompositeRepository) Search(query string, opts SearchOptions) ([]Match, error) {
+	// With nothing to merge, the layer's own index (possibly precompiled)
+	// gives the same results
+	if len(c.layers) == 1 {
+		matches, err := c.layers[0].Repo.Search(query, opts)
+		for i := range matches {
+			matches[i].Acronym.Senses = stampLayer(matches[i].Acronym.Senses, c.layers[0].Name)
+		}
+		return matches, err
+	}
+
+	c.indexOnce.Do(func() {
+		all, err := c.All()
+		if err != nil {
+			c.indexErr = fmt.Errorf("failed to build search index: %w", err)
+			return
+		}
+		c.index = newSearchIndex(all)
+	})
+	if c.indexErr != nil {
+		return nil, c.indexErr
+	}
+	return c.index.search(query, opts), nil
+}
+
+// Random returns a random acronym from the merged layers
+func (c *CompositeRepository) Random() (*Acronym, error) {
+	all, err := c.All()
+	if err != nil {
+		return nil, err
+	}
+	if len(all) == 0 {
+		return nil, ErrEmptyRepository
+	}
+	return &all[rand.Intn(len(all))], nil
+}
+
+// All returns every acronym across layers. Acronyms keep the order of the
+// lowest layer they appear in, with senses merged by precedence.
+func (c *CompositeRepository) All() ([]Acronym, error) {
+	merged := newAcronymSet()
+	for i := len(c.layers) - 1; i >= 0; i-- {
+		list, err := c.layers[i].Repo.All()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list layer %s: %w", c.layers[i].Name, err)
+		}
+		merged.overlay(list, c.layers[i].Name)
+	}
+	return merged.list, nil
+}
+
+// acronymSet accumulates acronyms from several layers, lowest precedence first
+type acronymSet struct {
+	index map[string]int
+	list  []Acronym
+}
+
+func newAcronymSet() *acronymSet {
+	return &acronymSet{index: make(map[string]int)}
+}
+
+// overlay adds acronyms from a higher precedence layer
+func (s *acronymSet) overlay(acronyms []Acronym, layer string) {
+	for _, a := range acronyms {
+		senses := stampLayer(a.Senses, layer)
+		idx, exists := s.index[a.Acronym]
+		if !exists {
+			s.index[a.Acronym] = len(s.list)
+			s.list = append(s.list, Acronym{Acronym: a.Acronym, Senses: senses})
+			continue
+		}
+		s.list[idx].Senses = mergeSenses(senses, s.list[idx].Senses)
+	}
+}
+
+// mergeSenses returns the higher senses followed by any lower senses whose
+// full form is not already present
+func mergeSenses(higher, lower []Sense) []Sense {
+	merged := append([]Sense(nil), higher...)
+	for _, sense := range lower {
+		if !hasFullForm(higher, sense.FullForm) {
+			merged = append(merged, sense)
+		}
+	}
+	return merged
+}
+
+// stampLayer copies senses, recording the layer they came from unless a
+// nested layer already did
+func stampLayer(senses []Sense, layer string) []Sense {
+	stamped := make([]Sense, len(senses))
+	for i, sense := range senses {
+		if sense.Layer == "" {
+			sense.Layer = layer
+		}
+		stamped[i] = sense
+	}
+	return stamped
+}
+
+func hasFullForm(senses []Sense, fullForm string) bool {
+	for _, s := range senses {
+		if strings.EqualFold(s.FullForm, fullForm) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package acronym
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Weights for the evidence a context word gives a sense. A hint written into
+// the dictionary is stronger evidence than a word that happens to appear in
+// the sense's own full form or definition.
+const (
+	hintWeight      = 1.0
+	senseWordWeight = 0.5
+)
+
+// RankedSense is a sense scored against the text around an acronym
+type RankedSense struct {
+	Sense Sense
+	// Score is this sense's share of the evidence found in the context, from
+	// 0 to 1. Every sense scores 0 when nothing in the context matched.
+	Score float64
+	// Cues are the hints and context words that supported the sense
+	Cues []string
+}
+
+// Disambiguate ranks an acronym's senses by how well the surrounding text
+// supports each one. A sense is supported by its context hints, when every
+// word of a hint appears in the text, and more weakly by words it shares with
+// the text in its full form, definition, synonyms and specialty. Senses with
+// equal support keep their dictionary rank.
+func (a Acronym) Disambiguate(context string) []RankedSense {
+	words := contextWords(context, a.Acronym)
+
+	ranked := make([]RankedSense, len(a.Senses))
+	raw := make([]float64, len(a.Senses))
+	total := 0.0
+	for i, sense := range a.Senses {
+		ranked[i] = RankedSense{Sense: sense}
+		counted := make(map[string]bool)
+
+		for _, hint := range sense.Context {
+			terms := tokenize(hint)
+			// Skip hints that match nothing, or only words an earlier hint
+			// already counted, as with "relapse" and "relapsing"
+			if len(terms) == 0 || !allPresent(terms, words) || allCounted(terms, counted) {
+				continue
+			}
+			raw[i] += hintWeight
+			ranked[i].Cues = append(ranked[i].Cues, strings.TrimSpace(hint))
+			for _, term := range terms {
+				counted[term] = true
+			}
+		}
+
+		for _, term := range tokenize(senseText(sense)) {
+			if counted[term] {
+				continue
+			}
+			counted[term] = true
+			if word, ok := words[term]; ok {
+				raw[i] += senseWordWeight
+				ranked[i].Cues = append(ranked[i].Cues, word)
+			}
+		}
+		total += raw[i]
+	}
+
+	if total > 0 {
+		for i := range ranked {
+			ranked[i].Score = raw[i] / total
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score > ranked[j].Score
+	})
+	return ranked
+}
+
+// InContext returns the sense the context most supports, which is the primary
+// sense when nothing in the context helps
+func (a Acronym) InContext(context string) Sense {
+	if len(a.Senses) < 2 {
+		return a.Primary()
+	}
+	return a.Disambiguate(context)[0].Sense
+}
+
+// contextWords maps each indexed term in the context to the word it was
+// written as, leaving out the acronym itself
+func contextWords(context, acronym string) map[string]string {
+	self := make(map[string]bool)
+	for _, term := range tokenize(acronym) {
+		self[term] = true
+	}
+
+	words := make(map[string]string)
+	for _, word := range strings.FieldsFunc(context, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		for _, term := range tokenize(word) {
+			if _, seen := words[term]; !seen && !self[term] {
+				words[term] = word
+			}
+		}
+	}
+	return words
+}
+
+// senseText is the text of a sense that can match words in a context
+func senseText(s Sense) string {
+	parts := []string{s.FullForm, s.Definition}
+	parts = append(parts, s.Synonyms...)
+	if s.Specialty != "" {
+		parts = append(parts, SpecialtyLabel(s.Specialty))
+	}
+	return strings.Join(parts, " ")
+}
+
+// allPresent reports whether every term is in words
+func allPresent(terms []string, words map[string]string) bool {
+	for _, term := range terms {
+		if _, ok := words[term]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// allCounted reports whether every term has already been counted
+func allCounted(terms []string, counted map[string]bool) bool {
+	for _, term := range terms {
+		if !counted[term] {
+			return false
+		}
+	}
+	return true
+}
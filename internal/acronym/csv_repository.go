@@ -1,90 +1,57 @@
 package acronym
 
 import (
+	"bytes"
 	_ "embed"
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"io"
-	"math/rand"
 	"os"
 	"strings"
 )
 
 //go:embed data/acronyms.csv
-var embeddedCSV string
+var embeddedCSV []byte
 
-// CSVRepository implements Repository using a CSV file
-type CSVRepository struct {
-	data map[string]Acronym
-	list []Acronym
-}
+// DefinitionSeparator splits the full form from the description in the CSV
+// definition column
+const DefinitionSeparator = "–"
 
-// NewEmbeddedCSVRepository creates a new CSV-based repository from embedded data
-func NewEmbeddedCSVRepository() (*CSVRepository, error) {
-	reader := csv.NewReader(strings.NewReader(embeddedCSV))
-	reader.FieldsPerRecord = -1 // Allow variable number of fields
-	repo := &CSVRepository{
-		data: make(map[string]Acronym),
-		list: []Acronym{},
+// NewEmbeddedCSVRepository creates a new repository from the embedded CSV data
+func NewEmbeddedCSVRepository() (*MemoryRepository, error) {
+	repo := newMemoryRepository(EmbeddedSource)
+	if err := repo.loadCSV(bytes.NewReader(embeddedCSV)); err != nil {
+		return nil, err
 	}
-
-	// Skip header
-	if _, err := reader.Read(); err != nil {
-		return nil, fmt.Errorf("failed to read CSV header: %w", err)
-	}
-
-	for {
-		record, err := reader.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return nil, fmt.Errorf("failed to read CSV record: %w", err)
-		}
-
-		if len(record) < 2 {
-			continue
-		}
-
-		// Parse the definition to extract full form and description
-		parts := strings.SplitN(record[1], "–", 2)
-		fullForm := strings.TrimSpace(parts[0])
-		definition := ""
-		if len(parts) > 1 {
-			definition = strings.TrimSpace(parts[1])
-		}
-
-		acronym := Acronym{
-			Acronym:    strings.ToUpper(record[0]),
-			FullForm:   fullForm,
-			Definition: definition,
-		}
-
-		repo.data[strings.ToUpper(record[0])] = acronym
-		repo.list = append(repo.list, acronym)
-	}
-
+	repo.buildFuzzyIndex()
 	return repo, nil
 }
 
-// NewCSVRepository creates a new CSV-based repository from a file path (for backwards compatibility)
-func NewCSVRepository(path string) (*CSVRepository, error) {
+// NewCSVRepository creates a new repository from a CSV file path
+func NewCSVRepository(path string) (*MemoryRepository, error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open CSV file: %w", err)
 	}
 	defer file.Close()
 
-	reader := csv.NewReader(file)
-	reader.FieldsPerRecord = -1 // Allow variable number of fields
-	repo := &CSVRepository{
-		data: make(map[string]Acronym),
-		list: []Acronym{},
+	repo := newMemoryRepository(path)
+	if err := repo.loadCSV(file); err != nil {
+		return nil, err
 	}
+	repo.buildFuzzyIndex()
+	return repo, nil
+}
+
+// loadCSV parses CSV rows from r and adds each one as a sense of its acronym
+func (r *MemoryRepository) loadCSV(in io.Reader) error {
+	reader := csv.NewReader(in)
+	reader.FieldsPerRecord = -1 // Allow variable number of fields
 
 	// Skip header
 	if _, err := reader.Read(); err != nil {
-		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+		return fmt.Errorf("failed to read CSV header: %w", r.csvError(err))
 	}
 
 	for {
@@ -93,202 +60,69 @@ func NewCSVRepository(path string) (*CSVRepository, error) {
 			break
 		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to read CSV record: %w", err)
+			return fmt.Errorf("failed to read CSV record: %w", r.csvError(err))
 		}
 
 		if len(record) < 2 {
 			continue
 		}
 
-		// Parse the definition to extract full form and description
-		parts := strings.SplitN(record[1], "–", 2)
-		fullForm := strings.TrimSpace(parts[0])
-		definition := ""
-		if len(parts) > 1 {
-			definition = strings.TrimSpace(parts[1])
-		}
-
-		acronym := Acronym{
-			Acronym:    strings.ToUpper(record[0]),
+		fullForm, definition := SplitDefinition(record[1])
+		entry := Entry{
+			Acronym:    record[0],
 			FullForm:   fullForm,
 			Definition: definition,
 		}
-
-		repo.data[strings.ToUpper(record[0])] = acronym
-		repo.list = append(repo.list, acronym)
+		// The specialty, context and alternative columns are optional. An
+		// alternative marks the abbreviation as do-not-use.
+		if len(record) > 2 {
+			entry.Specialty = record[2]
+		}
+		if len(record) > 3 {
+			entry.Context = SplitContext(record[3])
+		}
+		if len(record) > 4 && strings.TrimSpace(record[4]) != "" {
+			entry.DoNotUse = true
+			entry.Alternative = record[4]
+		}
+		r.addEntry(entry)
 	}
 
-	return repo, nil
+	return nil
 }
 
-// Find looks up an acronym by its abbreviation
-func (r *CSVRepository) Find(acronym string) (*Acronym, error) {
-	a, exists := r.data[strings.ToUpper(acronym)]
-	if !exists {
-		return nil, fmt.Errorf("acronym '%s' not found", acronym)
+// csvError reports an error reading the repository's CSV as a *ParseError,
+// with the position when the CSV reader gives one
+func (r *MemoryRepository) csvError(err error) error {
+	var csvErr *csv.ParseError
+	if errors.As(err, &csvErr) {
+		return &ParseError{Path: r.source, Line: csvErr.Line, Column: csvErr.Column, Err: csvErr.Err}
 	}
-	return &a, nil
+	return &ParseError{Path: r.source, Err: err}
 }
 
-// Random returns a random acronym
-func (r *CSVRepository) Random() (*Acronym, error) {
-	if len(r.list) == 0 {
-		return nil, fmt.Errorf("no acronyms available")
-	}
-	idx := rand.Intn(len(r.list))
-	return &r.list[idx], nil
-}
-
-// All returns all acronyms
-func (r *CSVRepository) All() ([]Acronym, error) {
-	return r.list, nil
-}
+// ContextSeparator separates the cues in a CSV context column
+const ContextSeparator = ";"
 
-// FindFuzzy performs fuzzy search on acronyms and returns top matches
-func (r *CSVRepository) FindFuzzy(acronym string, maxResults int) ([]Acronym, error) {
-	if maxResults <= 0 {
-		maxResults = 3
-	}
-	
-	acronymUpper := strings.ToUpper(acronym)
-	type scoredMatch struct {
-		acronym Acronym
-		score   int
-	}
-	
-	var matches []scoredMatch
-	
-	// Calculate similarity scores for all acronyms
-	for key, acr := range r.data {
-		score := calculateSimilarity(acronymUpper, key)
-		if score > 0 {
-			matches = append(matches, scoredMatch{acr, score})
-		}
-	}
-	
-	// Sort by score (higher is better)
-	for i := 0; i < len(matches)-1; i++ {
-		for j := i + 1; j < len(matches); j++ {
-			if matches[j].score > matches[i].score {
-				matches[i], matches[j] = matches[j], matches[i]
-			}
+// SplitContext splits a CSV context column into its cues
+func SplitContext(column string) []string {
+	var cues []string
+	for _, cue := range strings.Split(column, ContextSeparator) {
+		if cue = strings.TrimSpace(cue); cue != "" {
+			cues = append(cues, cue)
 		}
 	}
-	
-	// Return top matches
-	results := []Acronym{}
-	for i := 0; i < len(matches) && i < maxResults; i++ {
-		results = append(results, matches[i].acronym)
-	}
-	
-	if len(results) == 0 {
-		return nil, fmt.Errorf("no fuzzy matches found for '%s'", acronym)
-	}
-	
-	return results, nil
+	return cues
 }
 
-// calculateSimilarity calculates a similarity score between two strings
-// Higher score means more similar
-func calculateSimilarity(s1, s2 string) int {
-	// If strings are equal, perfect score
-	if s1 == s2 {
-		return 100
-	}
-	
-	// Calculate Levenshtein distance
-	dist := levenshteinDistance(s1, s2)
-	maxLen := len(s1)
-	if len(s2) > maxLen {
-		maxLen = len(s2)
-	}
-	
-	// If distance is too large, no match
-	// Allow up to 2 edits for short acronyms, 3 for longer ones
-	maxDist := 2
-	if maxLen > 4 {
-		maxDist = 3
-	}
-	if dist > maxDist {
-		return 0
-	}
-	
-	// Convert distance to similarity score
-	score := 100 - (dist * 100 / maxLen)
-	
-	// Boost score for common patterns
-	if strings.Contains(s2, s1) || strings.Contains(s1, s2) {
-		score += 20
+// SplitDefinition splits a CSV definition column on its en dash into the full
+// form and the description
+func SplitDefinition(column string) (string, string) {
+	parts := strings.SplitN(column, DefinitionSeparator, 2)
+	fullForm := strings.TrimSpace(parts[0])
+	definition := ""
+	if len(parts) > 1 {
+		definition = strings.TrimSpace(parts[1])
 	}
-	
-	// Boost for same prefix
-	minLen := len(s1)
-	if len(s2) < minLen {
-		minLen = len(s2)
-	}
-	for i := 0; i < minLen && i < 3; i++ {
-		if s1[i] == s2[i] {
-			score += 5
-		} else {
-			break
-		}
-	}
-	
-	return score
+	return fullForm, definition
 }
-
-// levenshteinDistance calculates the edit distance between two strings
-func levenshteinDistance(s1, s2 string) int {
-	if len(s1) == 0 {
-		return len(s2)
-	}
-	if len(s2) == 0 {
-		return len(s1)
-	}
-	
-	// Create matrix
-	matrix := make([][]int, len(s1)+1)
-	for i := range matrix {
-		matrix[i] = make([]int, len(s2)+1)
-	}
-	
-	// Initialize first column and row
-	for i := 0; i <= len(s1); i++ {
-		matrix[i][0] = i
-	}
-	for j := 0; j <= len(s2); j++ {
-		matrix[0][j] = j
-	}
-	
-	// Fill matrix
-	for i := 1; i <= len(s1); i++ {
-		for j := 1; j <= len(s2); j++ {
-			cost := 0
-			if s1[i-1] != s2[j-1] {
-				cost = 1
-			}
-			
-			matrix[i][j] = min(
-				matrix[i-1][j]+1,     // deletion
-				matrix[i][j-1]+1,     // insertion
-				matrix[i-1][j-1]+cost, // substitution
-			)
-		}
-	}
-	
-	return matrix[len(s1)][len(s2)]
-}
-
-// min returns the minimum of three integers
-func min(a, b, c int) int {
-	if a < b {
-		if a < c {
-			return a
-		}
-		return c
-	}
-	if b < c {
-		return b
-	}
-	return c
-}
\ No newline at end of file
@@ -0,0 +1,1412 @@
+// Code generated by go generate; DO NOT EDIT.
+
+//go:build !tmdr_compile
+
+package acronym
+
+const embeddedChecksum = 0x7895fe34
+
+var embeddedAcronyms = []Acronym{
+	{Acronym: "ABG", Senses: []Sense{
+		{FullForm: "Arterial Blood Gas", Definition: "A test measuring oxygen and carbon dioxide levels in arterial blood", Specialty: "laboratory", Source: "embedded"},
+	}},
+	{Acronym: "ACS", Senses: []Sense{
+		{FullForm: "Acute Coronary Syndrome", Definition: "A group of conditions caused by decreased blood flow in the coronary arteries", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "AD", Senses: []Sense{
+		{FullForm: "Right Ear", Definition: "From auris dextra; mistaken for OD (right eye) or AU (both ears)", Specialty: "clinical", Alternative: "right ear", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "ADHD", Senses: []Sense{
+		{FullForm: "Attention Deficit Hyperactivity Disorder", Definition: "A neurodevelopmental disorder affecting focus and behavior", Specialty: "psychiatry", Source: "embedded"},
+	}},
+	{Acronym: "AED", Senses: []Sense{
+		{FullForm: "Automated External Defibrillator", Definition: "A portable device that checks heart rhythm and can send electric shock", Specialty: "emergency", Source: "embedded"},
+	}},
+	{Acronym: "AFIB", Senses: []Sense{
+		{FullForm: "Atrial Fibrillation", Definition: "An irregular and often rapid heart rhythm", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "AKI", Senses: []Sense{
+		{FullForm: "Acute Kidney Injury", Definition: "Sudden decrease in kidney function", Specialty: "nephrology", Source: "embedded"},
+	}},
+	{Acronym: "ALS", Senses: []Sense{
+		{FullForm: "Amyotrophic Lateral Sclerosis", Definition: "A progressive neurodegenerative disease affecting nerve cells", Specialty: "neurology", Source: "embedded"},
+	}},
+	{Acronym: "AMI", Senses: []Sense{
+		{FullForm: "Acute Myocardial Infarction", Definition: "Heart attack caused by blocked blood flow to the heart", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "ARDS", Senses: []Sense{
+		{FullForm: "Acute Respiratory Distress Syndrome", Definition: "Life-threatening lung condition preventing enough oxygen", Specialty: "respiratory", Source: "embedded"},
+	}},
+	{Acronym: "AS", Senses: []Sense{
+		{FullForm: "Left Ear", Definition: "From auris sinistra; mistaken for OS (left eye) or AD (right ear)", Specialty: "clinical", Alternative: "left ear", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "ASA", Senses: []Sense{
+		{FullForm: "Aspirin", Definition: "Common medication used for pain relief and blood thinning", Specialty: "pharmacology", Source: "embedded"},
+	}},
+	{Acronym: "AU", Senses: []Sense{
+		{FullForm: "Both Ears", Definition: "From auris utraque; mistaken for OU (both eyes)", Specialty: "clinical", Alternative: "both ears", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "BMI", Senses: []Sense{
+		{FullForm: "Body Mass Index", Definition: "A measure of body fat based on height and weight", Specialty: "clinical", Source: "embedded"},
+	}},
+	{Acronym: "BNF", Senses: []Sense{
+		{FullForm: "British National Formulary", Definition: "A pharmaceutical reference book", Specialty: "pharmacology", Source: "embedded"},
+	}},
+	{Acronym: "BNP", Senses: []Sense{
+		{FullForm: "Brain Natriuretic Peptide", Definition: "A hormone produced by the heart", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "BP", Senses: []Sense{
+		{FullForm: "Blood Pressure", Definition: "The pressure of blood pushing against artery walls", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "BPM", Senses: []Sense{
+		{FullForm: "Beats Per Minute", Definition: "Heart rate measurement", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "CABG", Senses: []Sense{
+		{FullForm: "Coronary Artery Bypass Graft", Definition: "Surgery to improve blood flow to the heart", Specialty: "surgery", Source: "embedded"},
+	}},
+	{Acronym: "CAD", Senses: []Sense{
+		{FullForm: "Coronary Artery Disease", Definition: "Narrowing or blockage of coronary arteries", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "CBC", Senses: []Sense{
+		{FullForm: "Complete Blood Count", Definition: "Common blood test analyzing cellular components", Specialty: "laboratory", Source: "embedded"},
+	}},
+	{Acronym: "CC", Senses: []Sense{
+		{FullForm: "Cubic Centimetre", Definition: "Mistaken for U (units) when poorly written", Specialty: "pharmacology", Alternative: "mL", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "CHF", Senses: []Sense{
+		{FullForm: "Congestive Heart Failure", Definition: "Heart's inability to pump blood effectively", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "COPD", Senses: []Sense{
+		{FullForm: "Chronic Obstructive Pulmonary Disease", Definition: "Group of lung diseases causing breathing problems", Specialty: "respiratory", Source: "embedded"},
+	}},
+	{Acronym: "CPR", Senses: []Sense{
+		{FullForm: "Cardiopulmonary Resuscitation", Definition: "Emergency procedure for cardiac arrest", Specialty: "emergency", Source: "embedded"},
+	}},
+	{Acronym: "CRP", Senses: []Sense{
+		{FullForm: "C-Reactive Protein", Definition: "Blood test marker for inflammation", Specialty: "laboratory", Source: "embedded"},
+	}},
+	{Acronym: "CSF", Senses: []Sense{
+		{FullForm: "Cerebrospinal Fluid", Definition: "Clear fluid surrounding brain and spinal cord", Specialty: "neurology", Source: "embedded"},
+	}},
+	{Acronym: "CT", Senses: []Sense{
+		{FullForm: "Computed Tomography", Definition: "Medical imaging using X-rays for cross-sectional images", Specialty: "imaging", Source: "embedded"},
+	}},
+	{Acronym: "CVA", Senses: []Sense{
+		{FullForm: "Cerebrovascular Accident", Definition: "Stroke caused by interrupted blood flow to brain", Specialty: "neurology", Source: "embedded"},
+	}},
+	{Acronym: "CXR", Senses: []Sense{
+		{FullForm: "Chest X-Ray", Definition: "Radiographic image of the chest", Specialty: "imaging", Source: "embedded"},
+	}},
+	{Acronym: "D/C", Senses: []Sense{
+		{FullForm: "Discharge or Discontinue", Definition: "Ambiguous, and has led to medicines being stopped in error at discharge", Specialty: "clinical", Alternative: "discharge or discontinue", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "DKA", Senses: []Sense{
+		{FullForm: "Diabetic Ketoacidosis", Definition: "Serious diabetes complication with high blood acids", Specialty: "endocrinology", Source: "embedded"},
+	}},
+	{Acronym: "DM", Senses: []Sense{
+		{FullForm: "Diabetes Mellitus", Definition: "Group of metabolic disorders with high blood sugar", Specialty: "endocrinology", Source: "embedded"},
+	}},
+	{Acronym: "DNR", Senses: []Sense{
+		{FullForm: "Do Not Resuscitate", Definition: "Medical order to not perform CPR", Specialty: "admin", Source: "embedded"},
+	}},
+	{Acronym: "DVT", Senses: []Sense{
+		{FullForm: "Deep Vein Thrombosis", Definition: "Blood clot in a deep vein, usually in legs", Specialty: "hematology", Source: "embedded"},
+	}},
+	{Acronym: "ECG", Senses: []Sense{
+		{FullForm: "Electrocardiogram", Definition: "Test recording electrical activity of the heart", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "ECMO", Senses: []Sense{
+		{FullForm: "Extracorporeal Membrane Oxygenation", Definition: "Life support for severe heart/lung failure", Specialty: "emergency", Source: "embedded"},
+	}},
+	{Acronym: "ED", Senses: []Sense{
+		{FullForm: "Emergency Department", Definition: "Hospital department for urgent medical care", Specialty: "units", Source: "embedded"},
+	}},
+	{Acronym: "EEG", Senses: []Sense{
+		{FullForm: "Electroencephalogram", Definition: "Test detecting electrical activity in the brain", Specialty: "neurology", Source: "embedded"},
+	}},
+	{Acronym: "EKG", Senses: []Sense{
+		{FullForm: "Electrocardiogram", Definition: "Alternative abbreviation for ECG", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "EMR", Senses: []Sense{
+		{FullForm: "Electronic Medical Record", Definition: "Digital version of patient medical history", Specialty: "admin", Source: "embedded"},
+	}},
+	{Acronym: "ENT", Senses: []Sense{
+		{FullForm: "Ear Nose and Throat", Definition: "Medical specialty for head and neck disorders", Specialty: "surgery", Source: "embedded"},
+	}},
+	{Acronym: "ER", Senses: []Sense{
+		{FullForm: "Emergency Room", Definition: "Alternative term for Emergency Department", Specialty: "units", Source: "embedded"},
+	}},
+	{Acronym: "ESRD", Senses: []Sense{
+		{FullForm: "End-Stage Renal Disease", Definition: "Complete or near-complete kidney failure", Specialty: "nephrology", Source: "embedded"},
+	}},
+	{Acronym: "FBC", Senses: []Sense{
+		{FullForm: "Full Blood Count", Definition: "British term for Complete Blood Count", Specialty: "laboratory", Source: "embedded"},
+	}},
+	{Acronym: "GCS", Senses: []Sense{
+		{FullForm: "Glasgow Coma Scale", Definition: "Tool to assess level of consciousness", Specialty: "neurology", Source: "embedded"},
+	}},
+	{Acronym: "GERD", Senses: []Sense{
+		{FullForm: "Gastroesophageal Reflux Disease", Definition: "Chronic acid reflux condition", Specialty: "gastroenterology", Source: "embedded"},
+	}},
+	{Acronym: "GFR", Senses: []Sense{
+		{FullForm: "Glomerular Filtration Rate", Definition: "Test measuring kidney function", Specialty: "nephrology", Source: "embedded"},
+	}},
+	{Acronym: "HBA1C", Senses: []Sense{
+		{FullForm: "Hemoglobin A1c", Definition: "Blood test for average blood sugar over 2-3 months", Specialty: "endocrinology", Source: "embedded"},
+	}},
+	{Acronym: "HDL", Senses: []Sense{
+		{FullForm: "High-Density Lipoprotein", Definition: "Good cholesterol that removes bad cholesterol", Specialty: "laboratory", Source: "embedded"},
+	}},
+	{Acronym: "HIPAA", Senses: []Sense{
+		{FullForm: "Health Insurance Portability and Accountability Act", Definition: "US law protecting patient privacy", Specialty: "admin", Source: "embedded"},
+	}},
+	{Acronym: "HIV", Senses: []Sense{
+		{FullForm: "Human Immunodeficiency Virus", Definition: "Virus that attacks the immune system", Specialty: "infectious-disease", Source: "embedded"},
+	}},
+	{Acronym: "HR", Senses: []Sense{
+		{FullForm: "Heart Rate", Definition: "Number of heartbeats per minute", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "HS", Senses: []Sense{
+		{FullForm: "Half-Strength", Definition: "Mistaken for bedtime (hora somni)", Specialty: "pharmacology", Alternative: "half-strength", Source: "embedded", DoNotUse: true},
+		{FullForm: "Hour of Sleep", Definition: "Bedtime, mistaken for half-strength", Specialty: "pharmacology", Alternative: "bedtime", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "HTN", Senses: []Sense{
+		{FullForm: "Hypertension", Definition: "High blood pressure", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "IBD", Senses: []Sense{
+		{FullForm: "Inflammatory Bowel Disease", Definition: "Chronic inflammation of digestive tract", Specialty: "gastroenterology", Source: "embedded"},
+	}},
+	{Acronym: "IBS", Senses: []Sense{
+		{FullForm: "Irritable Bowel Syndrome", Definition: "Disorder affecting the large intestine", Specialty: "gastroenterology", Source: "embedded"},
+	}},
+	{Acronym: "ICU", Senses: []Sense{
+		{FullForm: "Intensive Care Unit", Definition: "Hospital unit for critically ill patients", Specialty: "units", Source: "embedded"},
+	}},
+	{Acronym: "IM", Senses: []Sense{
+		{FullForm: "Intramuscular", Definition: "Injection into muscle tissue", Specialty: "pharmacology", Source: "embedded"},
+	}},
+	{Acronym: "INR", Senses: []Sense{
+		{FullForm: "International Normalized Ratio", Definition: "Blood test measuring clotting time", Specialty: "hematology", Source: "embedded"},
+	}},
+	{Acronym: "IU", Senses: []Sense{
+		{FullForm: "International Unit", Definition: "Mistaken for IV (intravenous) or 10 (ten)", Specialty: "pharmacology", Alternative: "units", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "IV", Senses: []Sense{
+		{FullForm: "Intravenous", Definition: "Administration through a vein", Specialty: "pharmacology", Source: "embedded"},
+	}},
+	{Acronym: "LDL", Senses: []Sense{
+		{FullForm: "Low-Density Lipoprotein", Definition: "Bad cholesterol that can build up in arteries", Specialty: "laboratory", Source: "embedded"},
+	}},
+	{Acronym: "LOC", Senses: []Sense{
+		{FullForm: "Loss of Consciousness", Definition: "State of being unaware or unresponsive", Specialty: "neurology", Source: "embedded"},
+	}},
+	{Acronym: "LP", Senses: []Sense{
+		{FullForm: "Lumbar Puncture", Definition: "Procedure to collect cerebrospinal fluid", Specialty: "neurology", Source: "embedded"},
+	}},
+	{Acronym: "LVH", Senses: []Sense{
+		{FullForm: "Left Ventricular Hypertrophy", Definition: "Thickening of the left heart chamber wall", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "MGSO4", Senses: []Sense{
+		{FullForm: "Magnesium Sulfate", Definition: "Mistaken for morphine sulfate", Specialty: "pharmacology", Alternative: "magnesium sulfate", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "MI", Senses: []Sense{
+		{FullForm: "Myocardial Infarction", Definition: "Heart attack", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "MICU", Senses: []Sense{
+		{FullForm: "Medical Intensive Care Unit", Definition: "ICU for non-surgical critical patients", Specialty: "units", Source: "embedded"},
+	}},
+	{Acronym: "MRI", Senses: []Sense{
+		{FullForm: "Magnetic Resonance Imaging", Definition: "Medical imaging using magnetic fields", Specialty: "imaging", Source: "embedded"},
+	}},
+	{Acronym: "MRSA", Senses: []Sense{
+		{FullForm: "Methicillin-Resistant Staphylococcus Aureus", Definition: "Antibiotic-resistant bacteria", Specialty: "infectious-disease", Source: "embedded"},
+	}},
+	{Acronym: "MS", Senses: []Sense{
+		{FullForm: "Multiple Sclerosis", Definition: "Disease affecting central nervous system", Specialty: "neurology", Source: "embedded", Context: []string{"MRI", "lesion", "relapse", "relapsing", "demyelination", "neurologist", "optic neuritis", "disability", "interferon", "ocrelizumab"}},
+		{FullForm: "Morphine Sulfate", Definition: "Opioid analgesic used for severe pain", Specialty: "pharmacology", Alternative: "morphine sulfate", Source: "embedded", Context: []string{"opioid", "opiate", "dose", "mg", "analgesia", "PRN", "overdose", "naloxone", "oral", "IV"}, DoNotUse: true},
+		{FullForm: "Mitral Stenosis", Definition: "Narrowing of the mitral valve opening", Specialty: "cardiology", Source: "embedded", Context: []string{"mitral", "valve", "murmur", "echo", "echocardiogram", "rheumatic fever", "diastolic", "valvotomy", "AFib"}},
+	}},
+	{Acronym: "MSO4", Senses: []Sense{
+		{FullForm: "Morphine Sulfate", Definition: "Mistaken for magnesium sulfate", Specialty: "pharmacology", Alternative: "morphine sulfate", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "NICU", Senses: []Sense{
+		{FullForm: "Neonatal Intensive Care Unit", Definition: "ICU for newborn infants", Specialty: "units", Source: "embedded"},
+	}},
+	{Acronym: "NPO", Senses: []Sense{
+		{FullForm: "Nothing By Mouth", Definition: "Medical instruction to not eat or drink", Specialty: "clinical", Source: "embedded"},
+	}},
+	{Acronym: "NSAID", Senses: []Sense{
+		{FullForm: "Non-Steroidal Anti-Inflammatory Drug", Definition: "Pain relievers like ibuprofen", Specialty: "pharmacology", Source: "embedded"},
+	}},
+	{Acronym: "OCD", Senses: []Sense{
+		{FullForm: "Obsessive-Compulsive Disorder", Definition: "Mental health disorder with repetitive behaviors", Specialty: "psychiatry", Source: "embedded"},
+	}},
+	{Acronym: "OD", Senses: []Sense{
+		{FullForm: "Right Eye", Definition: "From oculus dexter; mistaken for once daily or AD (right ear)", Specialty: "clinical", Alternative: "right eye", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "OR", Senses: []Sense{
+		{FullForm: "Operating Room", Definition: "Hospital room for surgical procedures", Specialty: "units", Source: "embedded"},
+	}},
+	{Acronym: "OS", Senses: []Sense{
+		{FullForm: "Left Eye", Definition: "From oculus sinister; mistaken for oral (per os) or AS (left ear)", Specialty: "clinical", Alternative: "left eye", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "OSA", Senses: []Sense{
+		{FullForm: "Obstructive Sleep Apnea", Definition: "Breathing disorder during sleep", Specialty: "respiratory", Source: "embedded"},
+	}},
+	{Acronym: "OTC", Senses: []Sense{
+		{FullForm: "Over The Counter", Definition: "Medications available without prescription", Specialty: "pharmacology", Source: "embedded"},
+	}},
+	{Acronym: "OU", Senses: []Sense{
+		{FullForm: "Both Eyes", Definition: "From oculus uterque; mistaken for AU (both ears)", Specialty: "clinical", Alternative: "both eyes", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "PACU", Senses: []Sense{
+		{FullForm: "Post-Anesthesia Care Unit", Definition: "Recovery room after surgery", Specialty: "units", Source: "embedded"},
+	}},
+	{Acronym: "PCI", Senses: []Sense{
+		{FullForm: "Percutaneous Coronary Intervention", Definition: "Procedure to open blocked coronary arteries", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "PCR", Senses: []Sense{
+		{FullForm: "Polymerase Chain Reaction", Definition: "Lab technique for DNA amplification", Specialty: "laboratory", Source: "embedded"},
+	}},
+	{Acronym: "PE", Senses: []Sense{
+		{FullForm: "Pulmonary Embolism", Definition: "Blood clot in lung arteries", Specialty: "respiratory", Source: "embedded", Context: []string{"clot", "DVT", "d-dimer", "CTPA", "anticoagulation", "heparin", "embolus", "thrombolysis", "Wells", "pleuritic"}},
+		{FullForm: "Physical Examination", Definition: "Hands-on assessment of the patient by a clinician", Specialty: "clinical", Source: "embedded", Context: []string{"exam", "examination", "findings", "auscultation", "inspection", "palpation", "unremarkable", "vitals"}},
+	}},
+	{Acronym: "PET", Senses: []Sense{
+		{FullForm: "Positron Emission Tomography", Definition: "Imaging test using radioactive tracer", Specialty: "imaging", Source: "embedded"},
+	}},
+	{Acronym: "PICU", Senses: []Sense{
+		{FullForm: "Pediatric Intensive Care Unit", Definition: "ICU for critically ill children", Specialty: "units", Source: "embedded"},
+	}},
+	{Acronym: "PMH", Senses: []Sense{
+		{FullForm: "Past Medical History", Definition: "Patient's previous medical conditions", Specialty: "clinical", Source: "embedded"},
+	}},
+	{Acronym: "PO", Senses: []Sense{
+		{FullForm: "Per Os", Definition: "By mouth medication administration", Specialty: "pharmacology", Source: "embedded"},
+	}},
+	{Acronym: "PRN", Senses: []Sense{
+		{FullForm: "Pro Re Nata", Definition: "As needed medication dosing", Specialty: "pharmacology", Source: "embedded"},
+	}},
+	{Acronym: "PT", Senses: []Sense{
+		{FullForm: "Physical Therapy", Definition: "Treatment to improve movement and function", Specialty: "rehabilitation", Source: "embedded", Context: []string{"therapist", "rehab", "mobility", "exercise", "gait", "strength", "physio", "sessions", "ambulation"}},
+		{FullForm: "Prothrombin Time", Definition: "Blood test measuring how long blood takes to clot", Specialty: "hematology", Source: "embedded", Context: []string{"INR", "warfarin", "coagulation", "clotting", "APTT", "PTT", "bleeding", "seconds", "vitamin K"}},
+		{FullForm: "Patient", Definition: "Common shorthand for the patient in clinical notes", Specialty: "clinical", Source: "embedded", Context: []string{"presented", "presents", "complains", "admitted", "reports", "denies", "discharged", "c/o"}},
+	}},
+	{Acronym: "PTSD", Senses: []Sense{
+		{FullForm: "Post-Traumatic Stress Disorder", Definition: "Mental health condition after trauma", Specialty: "psychiatry", Source: "embedded"},
+	}},
+	{Acronym: "PVC", Senses: []Sense{
+		{FullForm: "Premature Ventricular Contraction", Definition: "Early heartbeat from ventricles", Specialty: "cardiology", Source: "embedded"},
+	}},
+	{Acronym: "QD", Senses: []Sense{
+		{FullForm: "Once Daily", Definition: "From quaque die; mistaken for QID (four times daily) when the period after Q looks like an I", Specialty: "pharmacology", Alternative: "daily", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "QOD", Senses: []Sense{
+		{FullForm: "Every Other Day", Definition: "Mistaken for QD (daily) or QID (four times daily)", Specialty: "pharmacology", Alternative: "every other day", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "RA", Senses: []Sense{
+		{FullForm: "Rheumatoid Arthritis", Definition: "Autoimmune disease affecting joints", Specialty: "rheumatology", Source: "embedded", Context: []string{"joint", "arthritis", "methotrexate", "synovitis", "rheumatoid factor", "stiffness", "DMARD", "swelling"}},
+		{FullForm: "Room Air", Definition: "Breathing without supplemental oxygen", Specialty: "respiratory", Source: "embedded", Context: []string{"oxygen", "O2", "saturation", "sats", "SpO2", "nasal cannula", "supplemental", "breathing"}},
+	}},
+	{Acronym: "RBC", Senses: []Sense{
+		{FullForm: "Red Blood Cell", Definition: "Blood cells carrying oxygen", Specialty: "hematology", Source: "embedded"},
+	}},
+	{Acronym: "ROM", Senses: []Sense{
+		{FullForm: "Range of Motion", Definition: "Extent of joint movement", Specialty: "rehabilitation", Source: "embedded"},
+	}},
+	{Acronym: "RR", Senses: []Sense{
+		{FullForm: "Respiratory Rate", Definition: "Number of breaths per minute", Specialty: "respiratory", Source: "embedded"},
+	}},
+	{Acronym: "RSV", Senses: []Sense{
+		{FullForm: "Respiratory Syncytial Virus", Definition: "Common respiratory virus", Specialty: "infectious-disease", Source: "embedded"},
+	}},
+	{Acronym: "RT", Senses: []Sense{
+		{FullForm: "Respiratory Therapy", Definition: "Treatment for breathing disorders", Specialty: "respiratory", Source: "embedded"},
+	}},
+	{Acronym: "SARS", Senses: []Sense{
+		{FullForm: "Severe Acute Respiratory Syndrome", Definition: "Viral respiratory illness", Specialty: "infectious-disease", Source: "embedded"},
+	}},
+	{Acronym: "SC", Senses: []Sense{
+		{FullForm: "Subcutaneous", Definition: "Mistaken for SL (sublingual) or SSI (sliding scale insulin)", Specialty: "pharmacology", Alternative: "subcut", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "SICU", Senses: []Sense{
+		{FullForm: "Surgical Intensive Care Unit", Definition: "ICU for post-surgical patients", Specialty: "units", Source: "embedded"},
+	}},
+	{Acronym: "SIDS", Senses: []Sense{
+		{FullForm: "Sudden Infant Death Syndrome", Definition: "Unexplained death of apparently healthy infant", Specialty: "pediatrics", Source: "embedded"},
+	}},
+	{Acronym: "SLE", Senses: []Sense{
+		{FullForm: "Systemic Lupus Erythematosus", Definition: "Autoimmune disease affecting multiple organs", Specialty: "rheumatology", Source: "embedded"},
+	}},
+	{Acronym: "SOB", Senses: []Sense{
+		{FullForm: "Shortness of Breath", Definition: "Difficulty breathing", Specialty: "respiratory", Source: "embedded"},
+	}},
+	{Acronym: "SQ", Senses: []Sense{
+		{FullForm: "Subcutaneous", Definition: "Mistaken for 5 every, as Q also stands for every", Specialty: "pharmacology", Alternative: "subcut", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "STAT", Senses: []Sense{
+		{FullForm: "Statim", Definition: "Immediately or urgently", Specialty: "clinical", Source: "embedded"},
+	}},
+	{Acronym: "STD", Senses: []Sense{
+		{FullForm: "Sexually Transmitted Disease", Definition: "Infections spread through sexual contact", Specialty: "infectious-disease", Source: "embedded"},
+	}},
+	{Acronym: "TBI", Senses: []Sense{
+		{FullForm: "Traumatic Brain Injury", Definition: "Brain dysfunction from external force", Specialty: "neurology", Source: "embedded"},
+	}},
+	{Acronym: "TIA", Senses: []Sense{
+		{FullForm: "Transient Ischemic Attack", Definition: "Mini-stroke with temporary symptoms", Specialty: "neurology", Source: "embedded"},
+	}},
+	{Acronym: "TID", Senses: []Sense{
+		{FullForm: "Ter In Die", Definition: "Three times a day medication dosing", Specialty: "pharmacology", Source: "embedded"},
+	}},
+	{Acronym: "TIW", Senses: []Sense{
+		{FullForm: "Three Times a Week", Definition: "Mistaken for three times a day or twice a week", Specialty: "pharmacology", Alternative: "three times weekly", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "TNF", Senses: []Sense{
+		{FullForm: "Tumor Necrosis Factor", Definition: "Protein involved in inflammation", Specialty: "rheumatology", Source: "embedded"},
+	}},
+	{Acronym: "TSH", Senses: []Sense{
+		{FullForm: "Thyroid Stimulating Hormone", Definition: "Hormone regulating thyroid function", Specialty: "endocrinology", Source: "embedded"},
+	}},
+	{Acronym: "U", Senses: []Sense{
+		{FullForm: "Unit", Definition: "Mistaken for 0 (zero), 4 (four) or cc when poorly written", Specialty: "pharmacology", Alternative: "unit", Source: "embedded", DoNotUse: true},
+	}},
+	{Acronym: "UA", Senses: []Sense{
+		{FullForm: "Urinalysis", Definition: "Urine test for various conditions", Specialty: "laboratory", Source: "embedded"},
+	}},
+	{Acronym: "URI", Senses: []Sense{
+		{FullForm: "Upper Respiratory Infection", Definition: "Common cold or similar infection", Specialty: "infectious-disease", Source: "embedded"},
+	}},
+	{Acronym: "UTI", Senses: []Sense{
+		{FullForm: "Urinary Tract Infection", Definition: "Infection in urinary system", Specialty: "infectious-disease", Source: "embedded"},
+	}},
+	{Acronym: "VTE", Senses: []Sense{
+		{FullForm: "Venous Thromboembolism", Definition: "Blood clot in vein", Specialty: "hematology", Source: "embedded"},
+	}},
+	{Acronym: "WBC", Senses: []Sense{
+		{FullForm: "White Blood Cell", Definition: "Blood cells fighting infection", Specialty: "hematology", Source: "embedded"},
+	}},
+	{Acronym: "WHO", Senses: []Sense{
+		{FullForm: "World Health Organization", Definition: "International public health agency", Specialty: "admin", Source: "embedded"},
+	}},
+}
+
+var embeddedKeys = &keyTrie{
+	Depth: 5,
+	Nodes: []trieNode{
+		{-1, 1, 22},
+		{-1, 22, 33},
+		{-1, 33, 36},
+		{-1, 36, 47},
+		{-1, 47, 52},
+		{-1, 52, 60},
+		{-1, 60, 61},
+		{-1, 61, 64},
+		{-1, 64, 70},
+		{-1, 70, 76},
+		{-1, 76, 80},
+		{-1, 80, 84},
+		{-1, 84, 87},
+		{-1, 87, 93},
+		{-1, 93, 102},
+		{-1, 102, 104},
+		{-1, 104, 110},
+		{-1, 110, 117},
+		{-1, 117, 121},
+		{118, 121, 124},
+		{-1, 124, 125},
+		{-1, 125, 127},
+		{-1, 127, 128},
+		{-1, 128, 129},
+		{2, 129, 130},
+		{-1, 130, 131},
+		{-1, 131, 132},
+		{-1, 132, 133},
+		{-1, 133, 134},
+		{-1, 134, 135},
+		{-1, 135, 136},
+		{10, 136, 137},
+		{12, 137, 137},
+		{-1, 137, 138},
+		{-1, 138, 140},
+		{16, 140, 141},
+		{-1, 141, 143},
+		{-1, 143, 144},
+		{21, 144, 144},
+		{-1, 144, 145},
+		{-1, 145, 146},
+		{-1, 146, 147},
+		{-1, 147, 148},
+		{-1, 148, 149},
+		{27, 149, 149},
+		{-1, 149, 150},
+		{-1, 150, 151},
+		{-1, 151, 152},
+		{-1, 152, 153},
+		{32, 153, 153},
+		{-1, 153, 154},
+		{-1, 154, 155},
+		{-1, 155, 157},
+		{37, 157, 157},
+		{-1, 157, 158},
+		{-1, 158, 159},
+		{-1, 159, 160},
+		{-1, 160, 161},
+		{42, 161, 161},
+		{-1, 161, 162},
+		{-1, 162, 163},
+		{-1, 163, 164},
+		{-1, 164, 165},
+		{-1, 165, 166},
+		{-1, 166, 167},
+		{-1, 167, 168},
+		{-1, 168, 170},
+		{52, 170, 170},
+		{53, 170, 170},
+		{-1, 170, 171},
+		{-1, 171, 173},
+		{-1, 173, 174},
+		{58, 174, 174},
+		{-1, 174, 175},
+		{60, 175, 175},
+		{61, 175, 175},
+		{-1, 175, 176},
+		{-1, 176, 177},
+		{64, 177, 177},
+		{-1, 177, 178},
+		{-1, 178, 179},
+		{67, 179, 180},
+		{-1, 180, 182},
+		{71, 182, 183},
+		{-1, 183, 184},
+		{-1, 184, 185},
+		{-1, 185, 186},
+		{-1, 186, 187},
+		{77, 187, 187},
+		{78, 187, 187},
+		{79, 187, 188},
+		{-1, 188, 189},
+		{82, 189, 189},
+		{-1, 189, 190},
+		{-1, 190, 192},
+		{86, 192, 193},
+		{-1, 193, 194},
+		{-1, 194, 195},
+		{90, 195, 195},
+		{-1, 195, 196},
+		{92, 196, 197},
+		{-1, 197, 198},
+		{95, 198, 198},
+		{-1, 198, 199},
+		{97, 199, 199},
+		{-1, 199, 200},
+		{-1, 200, 201},
+		{100, 201, 201},
+		{-1, 201, 202},
+		{102, 202, 202},
+		{-1, 202, 203},
+		{104, 203, 203},
+		{-1, 203, 205},
+		{-1, 205, 206},
+		{-1, 206, 207},
+		{109, 207, 207},
+		{-1, 207, 209},
+		{-1, 209, 210},
+		{-1, 210, 213},
+		{-1, 213, 214},
+		{-1, 214, 215},
+		{119, 215, 215},
+		{-1, 215, 216},
+		{-1, 216, 217},
+		{-1, 217, 218},
+		{-1, 218, 219},
+		{-1, 219, 220},
+		{0, 220, 220},
+		{1, 220, 220},
+		{-1, 220, 221},
+		{4, 221, 221},
+		{-1, 221, 222},
+		{6, 222, 222},
+		{7, 222, 222},
+		{8, 222, 222},
+		{-1, 222, 223},
+		{11, 223, 223},
+		{13, 223, 223},
+		{14, 223, 223},
+		{15, 223, 223},
+		{17, 223, 223},
+		{-1, 223, 224},
+		{19, 224, 224},
+		{20, 224, 224},
+		{22, 224, 224},
+		{-1, 224, 225},
+		{24, 225, 225},
+		{25, 225, 225},
+		{26, 225, 225},
+		{28, 225, 225},
+		{29, 225, 225},
+		{30, 225, 225},
+		{31, 225, 225},
+		{33, 225, 225},
+		{34, 225, 225},
+		{35, 225, 225},
+		{-1, 225, 226},
+		{38, 226, 226},
+		{39, 226, 226},
+		{40, 226, 226},
+		{41, 226, 226},
+		{-1, 226, 227},
+		{44, 227, 227},
+		{45, 227, 227},
+		{-1, 227, 228},
+		{47, 228, 228},
+		{-1, 228, 229},
+		{49, 229, 229},
+		{-1, 229, 230},
+		{51, 230, 230},
+		{54, 230, 230},
+		{55, 230, 230},
+		{56, 230, 230},
+		{57, 230, 230},
+		{59, 230, 230},
+		{62, 230, 230},
+		{63, 230, 230},
+		{65, 230, 230},
+		{-1, 230, 231},
+		{-1, 231, 232},
+		{69, 232, 232},
+		{-1, 232, 233},
+		{-1, 233, 234},
+		{-1, 234, 235},
+		{74, 235, 235},
+		{-1, 235, 236},
+		{76, 236, 236},
+		{80, 236, 236},
+		{81, 236, 236},
+		{-1, 236, 237},
+		{84, 237, 237},
+		{85, 237, 237},
+		{87, 237, 237},
+		{-1, 237, 238},
+		{89, 238, 238},
+		{91, 238, 238},
+		{-1, 238, 239},
+		{94, 239, 239},
+		{96, 239, 239},
+		{98, 239, 239},
+		{99, 239, 239},
+		{101, 239, 239},
+		{-1, 239, 240},
+		{-1, 240, 241},
+		{-1, 241, 242},
+		{107, 242, 242},
+		{108, 242, 242},
+		{-1, 242, 243},
+		{111, 243, 243},
+		{112, 243, 243},
+		{113, 243, 243},
+		{114, 243, 243},
+		{115, 243, 243},
+		{116, 243, 243},
+		{117, 243, 243},
+		{120, 243, 243},
+		{121, 243, 243},
+		{122, 243, 243},
+		{123, 243, 243},
+		{124, 243, 243},
+		{3, 243, 243},
+		{5, 243, 243},
+		{9, 243, 243},
+		{18, 243, 243},
+		{23, 243, 243},
+		{36, 243, 243},
+		{43, 243, 243},
+		{46, 243, 243},
+		{-1, 243, 244},
+		{-1, 244, 245},
+		{-1, 245, 246},
+		{68, 246, 246},
+		{70, 246, 246},
+		{72, 246, 246},
+		{73, 246, 246},
+		{-1, 246, 247},
+		{83, 247, 247},
+		{88, 247, 247},
+		{93, 247, 247},
+		{103, 247, 247},
+		{105, 247, 247},
+		{106, 247, 247},
+		{110, 247, 247},
+		{48, 247, 247},
+		{50, 247, 247},
+		{66, 247, 247},
+		{75, 247, 247},
+	},
+	Letters: []rune{0, 65, 66, 67, 68, 69, 70, 71, 72, 73, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 66, 67, 68, 69, 70, 75, 76, 77, 82, 83, 85, 77, 78, 80, 65, 66, 67, 72, 79, 80, 82, 83, 84, 86, 88, 47, 75, 77, 78, 86, 67, 68, 69, 75, 77, 78, 82, 83, 66, 67, 69, 70, 66, 68, 73, 82, 83, 84, 66, 67, 77, 78, 85, 86, 68, 79, 80, 86, 71, 73, 82, 83, 73, 80, 83, 67, 68, 82, 83, 84, 85, 65, 67, 69, 73, 77, 79, 82, 84, 86, 68, 79, 65, 66, 79, 82, 83, 84, 65, 67, 73, 76, 79, 81, 84, 66, 73, 78, 83, 65, 82, 84, 84, 66, 72, 71, 83, 72, 68, 73, 73, 83, 73, 68, 65, 73, 70, 80, 77, 66, 68, 67, 70, 80, 82, 80, 70, 65, 82, 67, 65, 82, 84, 71, 77, 71, 71, 82, 84, 82, 67, 83, 82, 82, 65, 76, 80, 86, 78, 68, 83, 85, 82, 76, 67, 72, 83, 67, 73, 83, 79, 67, 79, 65, 68, 65, 67, 67, 73, 82, 84, 67, 72, 78, 83, 67, 68, 67, 77, 86, 82, 67, 68, 69, 66, 65, 68, 73, 65, 68, 87, 70, 72, 73, 73, 69, 67, 79, 68, 66, 83, 71, 68, 79, 68, 68, 49, 65, 79, 85, 65, 52, 85, 73, 85, 85, 68, 83, 85, 83, 84, 67, 65, 52, 68},
+}
+
+var embeddedIndex = &searchIndex{
+	docs:  embeddedAcronyms,
+	Terms: []string{"0", "10", "2", "3", "4", "5", "a", "a1c", "abbrevi", "abg", "ac", "accid", "account", "acid", "act", "activ", "acut", "ad", "adhd", "admin", "administr", "affect", "afib", "after", "against", "agenc", "air", "aki", "al", "also", "altern", "ambigu", "ami", "amplif", "amyotroph", "analges", "analyz", "anesthesia", "anti", "antibiot", "apnea", "appar", "ard", "arrest", "arteri", "arthriti", "asa", "aspirin", "assess", "atrial", "attack", "attent", "au", "aureu", "auri", "autoimmun", "autom", "avail", "averag", "bacteria", "bad", "base", "be", "beat", "bedtim", "behavior", "block", "blockag", "blood", "bmi", "bnf", "bnp", "bodi", "book", "both", "bowel", "bp", "bpm", "brain", "breath", "british", "build", "bypass", "c", "cabg", "cad", "can", "carbon", "cardiac", "cardiolog", "cardiopulmonari", "care", "carri", "caus", "cbc", "cc", "cell", "cellular", "centimetr", "central", "cerebrospin", "cerebrovascular", "chain", "chamber", "check", "chest", "chf", "children", "cholesterol", "chronic", "clear", "clinic", "clinician", "clot", "cold", "collect", "coma", "common", "complet", "complic", "compon", "compuls", "comput", "condit", "congest", "conscious", "contact", "contract", "copd", "cord", "coronari", "count", "counter", "cpr", "critic", "cross", "crp", "csf", "ct", "cubic", "cva", "cxr", "d", "dai", "daili", "death", "decreas", "deep", "defibril", "deficit", "densiti", "depart", "detect", "devic", "dexter", "dextra", "diabet", "die", "difficulti", "digest", "digit", "dioxid", "discharg", "discontinu", "diseas", "disord", "distress", "dka", "dm", "dna", "dnr", "do", "dose", "drink", "drug", "dure", "dvt", "dysfunct", "ear", "earli", "eat", "ecg", "ecmo", "ed", "eeg", "effect", "ekg", "electr", "electrocardiogram", "electroencephalogram", "electron", "embol", "emerg", "emiss", "emr", "end", "endocrinolog", "enough", "ent", "er", "error", "erythematosu", "esrd", "everi", "examin", "extent", "extern", "extracorpor", "ey", "factor", "failur", "fat", "fbc", "fibril", "field", "fight", "filtrat", "flow", "fluid", "focu", "forc", "formulari", "four", "full", "function", "ga", "gastroenterolog", "gastroesophag", "gc", "gerd", "gfr", "glasgow", "glomerular", "good", "graft", "group", "ha", "half", "hand", "hba1c", "hdl", "head", "health", "healthi", "heart", "heartbeat", "height", "hematolog", "hemoglobin", "high", "hipaa", "histori", "hiv", "hora", "hormon", "hospit", "hour", "how", "hr", "hs", "htn", "human", "hyperact", "hypertens", "hypertrophi", "i", "ib", "ibd", "ibuprofen", "icu", "ill", "im", "imag", "immedi", "immun", "immunodefici", "improv", "inabl", "index", "infant", "infarct", "infect", "infecti", "inflamm", "inflammatori", "inject", "injuri", "inr", "instruct", "insulin", "insur", "intens", "intern", "interrupt", "intervent", "intestin", "intramuscular", "intraven", "involv", "irregular", "irrit", "ischem", "iu", "iv", "joint", "ketoacidosi", "kidnei", "lab", "laboratori", "larg", "later", "law", "ldl", "led", "left", "leg", "level", "life", "like", "lipoprotein", "loc", "long", "look", "loss", "low", "lp", "lumbar", "lung", "lupu", "lvh", "magnesium", "magnet", "marker", "mass", "measur", "medic", "medicin", "mellitu", "membran", "mental", "metabol", "methicillin", "mgso4", "mi", "micu", "mini", "minut", "mistaken", "mitral", "month", "morphin", "motion", "mouth", "movement", "mri", "mrsa", "ms", "mso4", "multipl", "muscl", "myocardi", "narrow", "nata", "nation", "natriuret", "near", "neck", "necrosi", "need", "neonat", "nephrolog", "nerv", "nervou", "neurodegen", "neurodevelopment", "neurolog", "newborn", "nicu", "non", "normal", "nose", "not", "note", "noth", "npo", "nsaid", "number", "obsess", "obstruct", "ocd", "oculu", "od", "often", "onc", "open", "oper", "opioid", "oral", "order", "organ", "os", "osa", "otc", "other", "ou", "over", "oxygen", "pacu", "pain", "past", "patient", "pci", "pcr", "pe", "pediatr", "peptid", "per", "percutan", "perform", "period", "pet", "pharmaceut", "pharmacolog", "physic", "picu", "pmh", "po", "polymeras", "poorli", "portabl", "positron", "post", "prematur", "prescript", "pressur", "prevent", "previou", "privaci", "prn", "pro", "problem", "procedur", "produc", "progress", "protect", "protein", "prothrombin", "psychiatri", "pt", "ptsd", "public", "pulmonari", "pump", "punctur", "push", "pvc", "q", "qd", "qid", "qod", "quaqu", "ra", "radioact", "radiograph", "rai", "rang", "rapid", "rate", "ratio", "rbc", "re", "reaction", "reactiv", "record", "recoveri", "red", "refer", "reflux", "regul", "rehabilit", "relief", "reliev", "remov", "renal", "repetit", "resist", "reson", "respiratori", "resuscit", "rheumatoid", "rheumatolog", "rhythm", "right", "rom", "room", "rr", "rsv", "rt", "s", "sar", "sc", "scale", "sclerosi", "section", "send", "seriou", "sever", "sexual", "shock", "short", "shorthand", "sicu", "sid", "similar", "sinist", "sinistra", "sl", "sle", "sleep", "slide", "sob", "somni", "specialti", "spinal", "spread", "sq", "ssi", "stage", "stand", "staphylococcu", "stat", "state", "statim", "std", "stenosi", "steroid", "stimul", "stop", "strength", "stress", "stroke", "subcutan", "sublingu", "sudden", "sugar", "sulfat", "supplement", "support", "surgeri", "surgic", "surround", "symptom", "syncyti", "syndrom", "system", "take", "tbi", "techniqu", "temporari", "ten", "ter", "term", "test", "therapi", "thicken", "thin", "threaten", "three", "throat", "thromboembol", "thrombosi", "through", "thyroid", "tia", "tid", "time", "tissu", "tiw", "tnf", "tomographi", "tool", "tracer", "tract", "transient", "transmit", "trauma", "traumat", "treatment", "tsh", "tumor", "twice", "u", "ua", "unawar", "unexplain", "unit", "unrespons", "up", "upper", "urgent", "uri", "urin", "urinalysi", "urinari", "us", "usual", "uterqu", "uti", "utraqu", "valv", "variou", "vein", "venou", "ventricl", "ventricular", "version", "viral", "viru", "vte", "wall", "wbc", "week", "weight", "when", "white", "who", "without", "world", "written", "x", "zero"},
+	Postings: [][]posting{
+		{{118, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{60, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{48, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{48, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{118, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{109, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{4, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{48, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{39, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{0, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{1, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{28, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{50, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{31, [numFields]uint16{0, 0, 0, 1, 0}}, {46, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{50, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{35, [numFields]uint16{0, 0, 0, 1, 0}}, {38, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{1, [numFields]uint16{0, 1, 0, 0, 0}}, {6, [numFields]uint16{0, 1, 0, 0, 0}}, {8, [numFields]uint16{0, 1, 0, 0, 0}}, {9, [numFields]uint16{0, 1, 0, 0, 0}}, {103, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{2, [numFields]uint16{1, 0, 0, 0, 0}}, {10, [numFields]uint16{0, 0, 0, 1, 0}}, {77, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{3, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{33, [numFields]uint16{0, 0, 0, 0, 1}}, {40, [numFields]uint16{0, 0, 0, 0, 1}}, {50, [numFields]uint16{0, 0, 0, 0, 1}}, {124, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{61, [numFields]uint16{0, 0, 0, 1, 0}}, {90, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{3, [numFields]uint16{0, 0, 0, 1, 0}}, {7, [numFields]uint16{0, 0, 0, 1, 0}}, {56, [numFields]uint16{0, 0, 0, 1, 0}}, {71, [numFields]uint16{0, 0, 0, 1, 0}}, {97, [numFields]uint16{0, 0, 0, 1, 0}}, {107, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{5, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{83, [numFields]uint16{0, 0, 0, 1, 0}}, {93, [numFields]uint16{0, 0, 0, 1, 0}}, {95, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{16, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{124, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{97, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{6, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{7, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{109, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{39, [numFields]uint16{0, 0, 0, 1, 0}}, {42, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{30, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{8, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{85, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{7, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{71, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{20, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{83, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{75, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{70, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{80, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{106, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{9, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{24, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{0, [numFields]uint16{0, 1, 0, 1, 0}}, {1, [numFields]uint16{0, 0, 0, 1, 0}}, {16, [numFields]uint16{0, 0, 0, 1, 0}}, {18, [numFields]uint16{0, 1, 0, 0, 0}}, {19, [numFields]uint16{0, 1, 0, 1, 0}}, {62, [numFields]uint16{0, 0, 0, 1, 0}}, {84, [numFields]uint16{0, 0, 0, 1, 0}}, {86, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{97, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{11, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{11, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{45, [numFields]uint16{0, 0, 0, 1, 0}}, {86, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{5, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{8, [numFields]uint16{0, 0, 0, 1, 0}}, {51, [numFields]uint16{0, 0, 0, 1, 0}}, {67, [numFields]uint16{0, 0, 0, 1, 0}}, {113, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{3, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{2, [numFields]uint16{0, 0, 0, 1, 0}}, {12, [numFields]uint16{1, 0, 0, 0, 0}}, {82, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{70, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{2, [numFields]uint16{0, 0, 0, 1, 0}}, {10, [numFields]uint16{0, 0, 0, 1, 0}}, {12, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{97, [numFields]uint16{0, 0, 0, 1, 0}}, {107, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{4, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{81, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{48, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{70, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{49, [numFields]uint16{0, 0, 0, 1, 0}}, {62, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{13, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{30, [numFields]uint16{0, 0, 0, 1, 0}}, {63, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{17, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{53, [numFields]uint16{0, 0, 0, 2, 0}}},
+		{{3, [numFields]uint16{0, 0, 0, 1, 0}}, {76, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{8, [numFields]uint16{0, 0, 0, 1, 0}}, {84, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{19, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{0, [numFields]uint16{0, 1, 0, 1, 0}}, {1, [numFields]uint16{0, 0, 0, 1, 0}}, {8, [numFields]uint16{0, 0, 0, 1, 0}}, {11, [numFields]uint16{0, 0, 0, 1, 0}}, {16, [numFields]uint16{0, 1, 0, 1, 0}}, {18, [numFields]uint16{0, 0, 0, 1, 0}}, {20, [numFields]uint16{0, 1, 0, 1, 0}}, {22, [numFields]uint16{0, 0, 0, 1, 0}}, {25, [numFields]uint16{0, 0, 0, 1, 0}}, {28, [numFields]uint16{0, 0, 0, 1, 0}}, {31, [numFields]uint16{0, 0, 0, 1, 0}}, {32, [numFields]uint16{0, 0, 0, 1, 0}}, {34, [numFields]uint16{0, 0, 0, 1, 0}}, {44, [numFields]uint16{0, 1, 0, 1, 0}}, {48, [numFields]uint16{0, 0, 0, 2, 0}}, {54, [numFields]uint16{0, 0, 0, 1, 0}}, {59, [numFields]uint16{0, 0, 0, 1, 0}}, {86, [numFields]uint16{0, 0, 0, 1, 0}}, {92, [numFields]uint16{0, 0, 0, 2, 0}}, {98, [numFields]uint16{0, 1, 0, 1, 0}}, {122, [numFields]uint16{0, 0, 0, 1, 0}}, {123, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{13, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{14, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{15, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{13, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{14, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{2, [numFields]uint16{0, 0, 0, 1, 0}}, {12, [numFields]uint16{0, 1, 0, 1, 0}}, {82, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{55, [numFields]uint16{0, 1, 0, 0, 0}}, {56, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{16, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{17, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{15, [numFields]uint16{0, 1, 0, 0, 0}}, {26, [numFields]uint16{0, 0, 0, 1, 0}}, {28, [numFields]uint16{0, 0, 0, 1, 0}}, {38, [numFields]uint16{0, 0, 0, 1, 0}}, {112, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{23, [numFields]uint16{0, 0, 0, 1, 0}}, {80, [numFields]uint16{0, 0, 0, 1, 0}}, {97, [numFields]uint16{0, 0, 0, 1, 0}}, {100, [numFields]uint16{0, 0, 0, 1, 0}}, {102, [numFields]uint16{0, 0, 0, 1, 0}}, {108, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{14, [numFields]uint16{0, 1, 0, 0, 0}}, {44, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{62, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{18, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{25, [numFields]uint16{0, 1, 0, 0, 0}}, {30, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{18, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{19, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{4, [numFields]uint16{0, 0, 0, 1, 0}}, {62, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{0, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{24, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{1, [numFields]uint16{0, 0, 0, 0, 1}}, {5, [numFields]uint16{0, 0, 0, 0, 1}}, {8, [numFields]uint16{0, 0, 0, 0, 1}}, {15, [numFields]uint16{0, 0, 0, 0, 1}}, {16, [numFields]uint16{0, 0, 0, 0, 1}}, {17, [numFields]uint16{0, 0, 0, 0, 1}}, {19, [numFields]uint16{0, 0, 0, 0, 1}}, {22, [numFields]uint16{0, 0, 0, 0, 1}}, {35, [numFields]uint16{0, 0, 0, 0, 1}}, {39, [numFields]uint16{0, 0, 0, 0, 1}}, {52, [numFields]uint16{0, 0, 0, 0, 1}}, {54, [numFields]uint16{0, 0, 0, 0, 1}}, {65, [numFields]uint16{0, 0, 0, 0, 1}}, {67, [numFields]uint16{0, 0, 0, 0, 1}}, {71, [numFields]uint16{0, 0, 0, 0, 1}}, {84, [numFields]uint16{0, 0, 0, 0, 1}}, {94, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{24, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{37, [numFields]uint16{0, 0, 0, 1, 0}}, {57, [numFields]uint16{0, 1, 0, 0, 0}}, {68, [numFields]uint16{0, 1, 0, 0, 0}}, {73, [numFields]uint16{0, 1, 0, 0, 0}}, {83, [numFields]uint16{0, 1, 0, 0, 0}}, {88, [numFields]uint16{0, 1, 0, 0, 0}}, {105, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{98, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{1, [numFields]uint16{0, 0, 0, 1, 0}}, {8, [numFields]uint16{0, 0, 0, 1, 0}}, {23, [numFields]uint16{0, 0, 0, 1, 0}}, {28, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{20, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{21, [numFields]uint16{1, 0, 0, 0, 0}}, {118, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{7, [numFields]uint16{0, 0, 0, 1, 0}}, {98, [numFields]uint16{0, 1, 0, 1, 0}}, {123, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{20, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{21, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{71, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{26, [numFields]uint16{0, 1, 0, 0, 0}}, {64, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{28, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{85, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{65, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{4, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{29, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{22, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{88, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{49, [numFields]uint16{0, 0, 0, 2, 0}}, {62, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{23, [numFields]uint16{0, 1, 0, 0, 0}}, {46, [numFields]uint16{0, 0, 0, 1, 0}}, {55, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{26, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{2, [numFields]uint16{0, 0, 0, 0, 1}}, {10, [numFields]uint16{0, 0, 0, 0, 1}}, {12, [numFields]uint16{0, 0, 0, 0, 1}}, {13, [numFields]uint16{0, 0, 0, 0, 1}}, {30, [numFields]uint16{0, 0, 0, 0, 1}}, {74, [numFields]uint16{0, 0, 0, 0, 1}}, {77, [numFields]uint16{0, 0, 0, 0, 1}}, {79, [numFields]uint16{0, 0, 0, 0, 1}}, {82, [numFields]uint16{0, 0, 0, 0, 1}}, {86, [numFields]uint16{0, 0, 0, 0, 1}}, {89, [numFields]uint16{0, 0, 0, 0, 1}}, {92, [numFields]uint16{0, 0, 0, 1, 1}}, {110, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{86, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{34, [numFields]uint16{0, 0, 0, 1, 0}}, {59, [numFields]uint16{0, 0, 0, 1, 0}}, {86, [numFields]uint16{0, 0, 0, 1, 0}}, {92, [numFields]uint16{0, 0, 0, 1, 0}}, {122, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{120, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{64, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{45, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{11, [numFields]uint16{0, 0, 0, 1, 0}}, {20, [numFields]uint16{0, 0, 0, 1, 0}}, {92, [numFields]uint16{0, 0, 0, 1, 0}}, {101, [numFields]uint16{0, 0, 0, 1, 0}}, {120, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{20, [numFields]uint16{0, 1, 0, 0, 0}}, {43, [numFields]uint16{0, 0, 0, 2, 0}}, {44, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{31, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{20, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{76, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{27, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{1, [numFields]uint16{0, 0, 0, 1, 0}}, {9, [numFields]uint16{0, 0, 0, 1, 0}}, {46, [numFields]uint16{0, 0, 0, 1, 0}}, {89, [numFields]uint16{0, 0, 0, 1, 0}}, {93, [numFields]uint16{0, 0, 0, 1, 0}}, {119, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{22, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{45, [numFields]uint16{0, 0, 0, 1, 0}}, {63, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{111, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{94, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{23, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{26, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{1, [numFields]uint16{0, 1, 0, 1, 0}}, {18, [numFields]uint16{0, 1, 0, 0, 0}}, {19, [numFields]uint16{0, 1, 0, 1, 0}}, {84, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{20, [numFields]uint16{0, 1, 0, 0, 0}}, {44, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{81, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{24, [numFields]uint16{1, 0, 0, 0, 0}}, {33, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{57, [numFields]uint16{0, 0, 0, 1, 0}}, {68, [numFields]uint16{0, 0, 0, 1, 0}}, {88, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{27, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{25, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{26, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{27, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{21, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{28, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{29, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{30, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{96, [numFields]uint16{0, 1, 0, 0, 0}}, {114, [numFields]uint16{0, 0, 0, 1, 0}}, {115, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{77, [numFields]uint16{0, 0, 0, 1, 0}}, {95, [numFields]uint16{0, 1, 0, 1, 0}}, {96, [numFields]uint16{0, 0, 0, 2, 0}}},
+		{{106, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{1, [numFields]uint16{0, 0, 0, 1, 0}}, {6, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{34, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{4, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{3, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{49, [numFields]uint16{0, 1, 0, 0, 0}}, {62, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{37, [numFields]uint16{0, 1, 0, 1, 0}}, {42, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{38, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{4, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{77, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{2, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{31, [numFields]uint16{0, 1, 0, 1, 0}}, {32, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{95, [numFields]uint16{0, 0, 0, 1, 0}}, {114, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{108, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{55, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{40, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{0, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{30, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{30, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{7, [numFields]uint16{0, 0, 0, 1, 0}}, {19, [numFields]uint16{0, 1, 0, 0, 0}}, {23, [numFields]uint16{0, 1, 0, 1, 0}}, {43, [numFields]uint16{0, 1, 0, 0, 0}}, {46, [numFields]uint16{0, 1, 0, 0, 0}}, {51, [numFields]uint16{0, 0, 0, 0, 1}}, {55, [numFields]uint16{0, 1, 0, 0, 0}}, {70, [numFields]uint16{0, 0, 0, 0, 1}}, {71, [numFields]uint16{0, 0, 0, 1, 0}}, {97, [numFields]uint16{0, 0, 0, 1, 0}}, {101, [numFields]uint16{0, 0, 0, 0, 1}}, {103, [numFields]uint16{0, 0, 0, 0, 1}}, {107, [numFields]uint16{0, 0, 0, 1, 0}}, {111, [numFields]uint16{0, 1, 0, 0, 1}}, {120, [numFields]uint16{0, 0, 0, 0, 1}}, {121, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{3, [numFields]uint16{0, 1, 0, 1, 0}}, {32, [numFields]uint16{0, 0, 0, 1, 0}}, {41, [numFields]uint16{0, 0, 0, 1, 0}}, {56, [numFields]uint16{0, 0, 0, 1, 0}}, {76, [numFields]uint16{0, 1, 0, 1, 0}}, {80, [numFields]uint16{0, 0, 0, 1, 0}}, {93, [numFields]uint16{0, 1, 0, 0, 0}}, {102, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{9, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{31, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{32, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{85, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{33, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{33, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{91, [numFields]uint16{0, 0, 0, 1, 0}}, {114, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{74, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{75, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{80, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{34, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{112, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{2, [numFields]uint16{0, 1, 0, 1, 0}}, {10, [numFields]uint16{0, 1, 0, 1, 0}}, {12, [numFields]uint16{0, 1, 0, 0, 0}}, {41, [numFields]uint16{0, 1, 0, 0, 0}}, {77, [numFields]uint16{0, 0, 0, 1, 0}}, {79, [numFields]uint16{0, 0, 0, 1, 0}}, {82, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{94, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{74, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{35, [numFields]uint16{1, 0, 0, 0, 0}}, {39, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{36, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{37, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{38, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{22, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{39, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{4, [numFields]uint16{0, 0, 0, 1, 0}}, {35, [numFields]uint16{0, 0, 0, 1, 0}}, {38, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{35, [numFields]uint16{0, 1, 0, 0, 0}}, {39, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{38, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{40, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{86, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{4, [numFields]uint16{0, 0, 0, 0, 1}}, {24, [numFields]uint16{0, 0, 0, 1, 1}}, {36, [numFields]uint16{0, 0, 0, 0, 1}}, {37, [numFields]uint16{0, 1, 0, 0, 0}}, {42, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{87, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{40, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{43, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{31, [numFields]uint16{0, 0, 0, 0, 1}}, {32, [numFields]uint16{0, 0, 0, 0, 1}}, {48, [numFields]uint16{0, 0, 0, 0, 1}}, {117, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{9, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{41, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{42, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{30, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{107, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{43, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{96, [numFields]uint16{0, 1, 0, 0, 0}}, {109, [numFields]uint16{0, 0, 0, 2, 0}}},
+		{{86, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{99, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{4, [numFields]uint16{0, 1, 0, 0, 0}}, {112, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{36, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{2, [numFields]uint16{0, 0, 0, 1, 0}}, {10, [numFields]uint16{0, 0, 0, 1, 0}}, {12, [numFields]uint16{0, 0, 0, 1, 0}}, {77, [numFields]uint16{0, 1, 0, 0, 0}}, {79, [numFields]uint16{0, 1, 0, 0, 0}}, {82, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{116, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{22, [numFields]uint16{0, 1, 0, 0, 0}}, {36, [numFields]uint16{0, 0, 0, 1, 0}}, {43, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{13, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{44, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{5, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{69, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{123, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{47, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{1, [numFields]uint16{0, 0, 0, 1, 0}}, {8, [numFields]uint16{0, 0, 0, 1, 0}}, {18, [numFields]uint16{0, 0, 0, 1, 0}}, {28, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{26, [numFields]uint16{0, 1, 0, 1, 0}}, {64, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{3, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{112, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{14, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{95, [numFields]uint16{0, 0, 0, 1, 0}}, {96, [numFields]uint16{0, 0, 0, 1, 0}}, {118, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{44, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{6, [numFields]uint16{0, 0, 0, 1, 0}}, {47, [numFields]uint16{0, 0, 0, 1, 0}}, {92, [numFields]uint16{0, 0, 0, 1, 0}}, {117, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{0, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{46, [numFields]uint16{0, 0, 0, 0, 1}}, {55, [numFields]uint16{0, 0, 0, 0, 1}}, {56, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{46, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{45, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{46, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{47, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{45, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{47, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{49, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{18, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{1, [numFields]uint16{0, 0, 0, 1, 0}}, {23, [numFields]uint16{0, 0, 0, 1, 0}}, {32, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{30, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{53, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{86, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{48, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{49, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{41, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{50, [numFields]uint16{0, 1, 0, 0, 0}}, {76, [numFields]uint16{0, 0, 0, 1, 0}}, {93, [numFields]uint16{0, 0, 0, 1, 0}}, {124, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{106, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{4, [numFields]uint16{0, 0, 0, 1, 0}}, {5, [numFields]uint16{0, 0, 0, 1, 0}}, {8, [numFields]uint16{0, 0, 0, 2, 0}}, {15, [numFields]uint16{0, 0, 0, 1, 0}}, {17, [numFields]uint16{0, 0, 0, 1, 0}}, {18, [numFields]uint16{0, 0, 0, 1, 0}}, {22, [numFields]uint16{0, 1, 0, 1, 0}}, {35, [numFields]uint16{0, 0, 0, 1, 0}}, {36, [numFields]uint16{0, 0, 0, 1, 0}}, {52, [numFields]uint16{0, 1, 0, 0, 0}}, {65, [numFields]uint16{0, 0, 0, 1, 0}}, {67, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{52, [numFields]uint16{0, 0, 0, 1, 0}}, {94, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{13, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{34, [numFields]uint16{0, 0, 0, 0, 1}}, {59, [numFields]uint16{0, 0, 0, 0, 1}}, {92, [numFields]uint16{0, 0, 0, 0, 1}}, {98, [numFields]uint16{0, 0, 0, 0, 1}}, {122, [numFields]uint16{0, 0, 0, 0, 1}}, {123, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{48, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{31, [numFields]uint16{0, 0, 0, 1, 0}}, {32, [numFields]uint16{0, 0, 0, 1, 0}}, {49, [numFields]uint16{0, 1, 0, 0, 0}}, {54, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{50, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{40, [numFields]uint16{0, 0, 0, 1, 0}}, {89, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{51, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{53, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{15, [numFields]uint16{0, 0, 0, 1, 0}}, {117, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{37, [numFields]uint16{0, 0, 0, 1, 0}}, {57, [numFields]uint16{0, 0, 0, 1, 0}}, {78, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{53, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{92, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{52, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{53, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{54, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{51, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{3, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{54, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{65, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{95, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{56, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{55, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{75, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{57, [numFields]uint16{1, 0, 0, 0, 0}}, {68, [numFields]uint16{0, 0, 0, 1, 0}}, {73, [numFields]uint16{0, 0, 0, 1, 0}}, {88, [numFields]uint16{0, 0, 0, 1, 0}}, {105, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{57, [numFields]uint16{0, 0, 0, 1, 0}}, {88, [numFields]uint16{0, 0, 0, 1, 0}}, {103, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{58, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{27, [numFields]uint16{0, 0, 0, 2, 1}}, {29, [numFields]uint16{0, 0, 0, 1, 1}}, {69, [numFields]uint16{0, 1, 0, 1, 1}}, {87, [numFields]uint16{0, 0, 0, 1, 1}}},
+		{{110, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{51, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{51, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{18, [numFields]uint16{0, 0, 0, 1, 0}}, {92, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{22, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{13, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{73, [numFields]uint16{0, 0, 0, 1, 0}}, {106, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{8, [numFields]uint16{0, 1, 0, 0, 0}}, {67, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{111, [numFields]uint16{0, 0, 0, 1, 0}}, {120, [numFields]uint16{0, 1, 0, 1, 0}}, {121, [numFields]uint16{0, 1, 0, 1, 0}}, {123, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{51, [numFields]uint16{0, 0, 0, 0, 1}}, {70, [numFields]uint16{0, 0, 0, 0, 1}}, {101, [numFields]uint16{0, 0, 0, 0, 1}}, {103, [numFields]uint16{0, 0, 0, 0, 1}}, {111, [numFields]uint16{0, 0, 0, 0, 1}}, {120, [numFields]uint16{0, 0, 0, 0, 1}}, {121, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{25, [numFields]uint16{0, 0, 0, 1, 0}}, {55, [numFields]uint16{0, 0, 0, 1, 0}}, {116, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{55, [numFields]uint16{0, 1, 0, 0, 0}}, {75, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{58, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{6, [numFields]uint16{0, 1, 0, 0, 0}}, {112, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{59, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{74, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{104, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{50, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{57, [numFields]uint16{0, 1, 0, 0, 0}}, {68, [numFields]uint16{0, 1, 0, 0, 0}}, {73, [numFields]uint16{0, 1, 0, 0, 0}}, {88, [numFields]uint16{0, 1, 0, 0, 0}}, {105, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{59, [numFields]uint16{0, 1, 0, 0, 0}}, {60, [numFields]uint16{0, 1, 0, 0, 0}}, {124, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{28, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{84, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{56, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{58, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{60, [numFields]uint16{0, 0, 0, 1, 0}}, {61, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{116, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{5, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{56, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{113, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{60, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{60, [numFields]uint16{0, 0, 0, 1, 0}}, {61, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{97, [numFields]uint16{0, 0, 0, 1, 0}}, {99, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{31, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{6, [numFields]uint16{0, 1, 0, 1, 0}}, {43, [numFields]uint16{0, 0, 0, 1, 0}}, {47, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{85, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{0, [numFields]uint16{0, 0, 0, 0, 1}}, {20, [numFields]uint16{0, 0, 0, 0, 1}}, {25, [numFields]uint16{0, 0, 0, 0, 1}}, {44, [numFields]uint16{0, 0, 0, 0, 1}}, {49, [numFields]uint16{0, 0, 0, 0, 1}}, {62, [numFields]uint16{0, 0, 0, 0, 1}}, {85, [numFields]uint16{0, 0, 0, 0, 1}}, {119, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{56, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{7, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{50, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{62, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{30, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{10, [numFields]uint16{0, 1, 0, 1, 0}}, {65, [numFields]uint16{0, 1, 0, 1, 0}}, {79, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{34, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{0, [numFields]uint16{0, 0, 0, 1, 0}}, {45, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{9, [numFields]uint16{0, 0, 0, 1, 0}}, {36, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{75, [numFields]uint16{0, 0, 0, 1, 0}}, {95, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{49, [numFields]uint16{0, 1, 0, 0, 0}}, {62, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{63, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{92, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{95, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{63, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{62, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{64, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{64, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{9, [numFields]uint16{0, 0, 0, 1, 0}}, {23, [numFields]uint16{0, 0, 0, 1, 0}}, {36, [numFields]uint16{0, 0, 0, 1, 0}}, {86, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{107, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{65, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{66, [numFields]uint16{0, 1, 0, 0, 0}}, {72, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{69, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{25, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{13, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{0, [numFields]uint16{0, 0, 0, 1, 0}}, {13, [numFields]uint16{0, 0, 0, 1, 0}}, {17, [numFields]uint16{0, 0, 0, 1, 0}}, {47, [numFields]uint16{0, 0, 0, 1, 0}}, {59, [numFields]uint16{0, 0, 0, 1, 0}}, {92, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{11, [numFields]uint16{0, 0, 0, 1, 0}}, {27, [numFields]uint16{0, 0, 0, 1, 0}}, {33, [numFields]uint16{0, 0, 0, 1, 0}}, {37, [numFields]uint16{0, 0, 0, 1, 0}}, {40, [numFields]uint16{0, 1, 0, 1, 0}}, {41, [numFields]uint16{0, 0, 0, 1, 0}}, {68, [numFields]uint16{0, 1, 0, 0, 0}}, {69, [numFields]uint16{0, 0, 0, 1, 0}}, {74, [numFields]uint16{0, 0, 0, 1, 0}}, {81, [numFields]uint16{0, 0, 0, 1, 0}}, {89, [numFields]uint16{0, 1, 0, 1, 0}}, {90, [numFields]uint16{0, 0, 0, 1, 0}}, {91, [numFields]uint16{0, 0, 0, 1, 0}}, {114, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{30, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{32, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{36, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{76, [numFields]uint16{0, 0, 0, 1, 0}}, {93, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{32, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{70, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{66, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{67, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{68, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{113, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{17, [numFields]uint16{0, 1, 0, 0, 0}}, {52, [numFields]uint16{0, 0, 0, 1, 0}}, {100, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{2, [numFields]uint16{0, 0, 0, 1, 0}}, {10, [numFields]uint16{0, 0, 0, 1, 0}}, {12, [numFields]uint16{0, 0, 0, 1, 0}}, {21, [numFields]uint16{0, 0, 0, 1, 0}}, {53, [numFields]uint16{0, 0, 0, 2, 0}}, {60, [numFields]uint16{0, 0, 0, 1, 0}}, {66, [numFields]uint16{0, 0, 0, 1, 0}}, {72, [numFields]uint16{0, 0, 0, 1, 0}}, {77, [numFields]uint16{0, 0, 0, 1, 0}}, {79, [numFields]uint16{0, 0, 0, 1, 0}}, {82, [numFields]uint16{0, 0, 0, 1, 0}}, {95, [numFields]uint16{0, 0, 0, 1, 0}}, {96, [numFields]uint16{0, 0, 0, 1, 0}}, {104, [numFields]uint16{0, 0, 0, 1, 0}}, {109, [numFields]uint16{0, 0, 0, 1, 0}}, {115, [numFields]uint16{0, 0, 0, 1, 0}}, {118, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{71, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{48, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{66, [numFields]uint16{0, 0, 0, 1, 0}}, {71, [numFields]uint16{0, 1, 0, 0, 0}}, {72, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{99, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{74, [numFields]uint16{0, 1, 0, 0, 0}}, {90, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{92, [numFields]uint16{0, 0, 0, 1, 0}}, {99, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{69, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{70, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{71, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{72, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{71, [numFields]uint16{0, 1, 0, 0, 0}}, {107, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{58, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{8, [numFields]uint16{0, 1, 0, 0, 0}}, {67, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{19, [numFields]uint16{0, 0, 0, 1, 0}}, {71, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{91, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{14, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{15, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{43, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{41, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{116, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{91, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{73, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{6, [numFields]uint16{0, 0, 0, 0, 1}}, {43, [numFields]uint16{0, 0, 0, 0, 1}}, {47, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{7, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{71, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{7, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{3, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{7, [numFields]uint16{0, 0, 0, 0, 1}}, {26, [numFields]uint16{0, 0, 0, 0, 1}}, {28, [numFields]uint16{0, 0, 0, 0, 1}}, {38, [numFields]uint16{0, 0, 0, 0, 1}}, {45, [numFields]uint16{0, 0, 0, 0, 1}}, {63, [numFields]uint16{0, 0, 0, 0, 1}}, {64, [numFields]uint16{0, 0, 0, 0, 1}}, {71, [numFields]uint16{0, 0, 0, 0, 1}}, {112, [numFields]uint16{0, 0, 0, 0, 1}}, {113, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{73, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{73, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{68, [numFields]uint16{0, 0, 0, 1, 0}}, {75, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{59, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{41, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{33, [numFields]uint16{0, 1, 0, 1, 0}}, {74, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{92, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{74, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{74, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{75, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{52, [numFields]uint16{0, 0, 0, 1, 0}}, {100, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{76, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{23, [numFields]uint16{0, 1, 0, 0, 0}}, {80, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{76, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{77, [numFields]uint16{0, 0, 0, 1, 0}}, {79, [numFields]uint16{0, 0, 0, 1, 0}}, {82, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{2, [numFields]uint16{0, 0, 0, 1, 0}}, {77, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{5, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{77, [numFields]uint16{0, 0, 0, 1, 0}}, {95, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{71, [numFields]uint16{0, 0, 0, 1, 0}}, {84, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{78, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{71, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{79, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{33, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{107, [numFields]uint16{0, 0, 0, 1, 0}}, {124, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{10, [numFields]uint16{0, 0, 0, 1, 0}}, {79, [numFields]uint16{1, 0, 0, 1, 0}}, {90, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{80, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{81, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{96, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{12, [numFields]uint16{0, 0, 0, 1, 0}}, {82, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{48, [numFields]uint16{0, 0, 0, 1, 0}}, {81, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{0, [numFields]uint16{0, 0, 0, 1, 0}}, {9, [numFields]uint16{0, 0, 0, 1, 0}}, {36, [numFields]uint16{0, 1, 0, 0, 0}}, {97, [numFields]uint16{0, 0, 0, 1, 0}}, {98, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{83, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{11, [numFields]uint16{0, 0, 0, 1, 0}}, {71, [numFields]uint16{0, 0, 0, 1, 0}}, {75, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{89, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{40, [numFields]uint16{0, 0, 0, 1, 0}}, {50, [numFields]uint16{0, 0, 0, 1, 0}}, {57, [numFields]uint16{0, 0, 0, 1, 0}}, {68, [numFields]uint16{0, 0, 0, 1, 0}}, {86, [numFields]uint16{0, 0, 0, 1, 0}}, {89, [numFields]uint16{0, 0, 0, 1, 0}}, {92, [numFields]uint16{0, 1, 0, 1, 0}}, {105, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{84, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{85, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{86, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{88, [numFields]uint16{0, 1, 0, 0, 0}}, {106, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{15, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{17, [numFields]uint16{0, 1, 0, 0, 0}}, {52, [numFields]uint16{0, 0, 0, 1, 0}}, {79, [numFields]uint16{0, 0, 0, 1, 0}}, {90, [numFields]uint16{0, 1, 0, 0, 0}}, {100, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{84, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{33, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{95, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{87, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{14, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{11, [numFields]uint16{0, 0, 0, 0, 1}}, {14, [numFields]uint16{0, 0, 0, 0, 1}}, {21, [numFields]uint16{0, 0, 0, 0, 1}}, {53, [numFields]uint16{0, 0, 0, 0, 2}}, {58, [numFields]uint16{0, 0, 0, 0, 1}}, {60, [numFields]uint16{0, 0, 0, 0, 1}}, {61, [numFields]uint16{0, 0, 0, 0, 1}}, {66, [numFields]uint16{0, 0, 0, 0, 1}}, {71, [numFields]uint16{0, 0, 0, 0, 1}}, {72, [numFields]uint16{0, 0, 0, 0, 1}}, {75, [numFields]uint16{0, 0, 0, 0, 1}}, {81, [numFields]uint16{0, 0, 0, 0, 1}}, {90, [numFields]uint16{0, 0, 0, 0, 1}}, {91, [numFields]uint16{0, 0, 0, 0, 1}}, {95, [numFields]uint16{0, 0, 0, 0, 1}}, {96, [numFields]uint16{0, 0, 0, 0, 1}}, {104, [numFields]uint16{0, 0, 0, 0, 1}}, {109, [numFields]uint16{0, 0, 0, 0, 1}}, {114, [numFields]uint16{0, 0, 0, 0, 1}}, {115, [numFields]uint16{0, 0, 0, 0, 1}}, {118, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{86, [numFields]uint16{0, 1, 0, 0, 0}}, {92, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{88, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{89, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{90, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{85, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{21, [numFields]uint16{0, 0, 0, 1, 0}}, {118, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{4, [numFields]uint16{0, 0, 0, 1, 0}}, {50, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{87, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{83, [numFields]uint16{0, 1, 0, 0, 0}}, {93, [numFields]uint16{0, 1, 0, 0, 0}}, {105, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{94, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{81, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{16, [numFields]uint16{0, 1, 0, 1, 0}}, {54, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{9, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{89, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{50, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{91, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{91, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{23, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{24, [numFields]uint16{0, 0, 0, 1, 0}}, {64, [numFields]uint16{0, 0, 0, 1, 0}}, {78, [numFields]uint16{0, 0, 0, 1, 0}}, {84, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{15, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{7, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{50, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{25, [numFields]uint16{0, 1, 0, 0, 0}}, {116, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{92, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{3, [numFields]uint16{0, 0, 0, 0, 1}}, {76, [numFields]uint16{0, 0, 0, 0, 1}}, {93, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{92, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{93, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{124, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{23, [numFields]uint16{0, 1, 0, 0, 0}}, {86, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{22, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{64, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{16, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{94, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{95, [numFields]uint16{0, 0, 0, 1, 0}}, {109, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{95, [numFields]uint16{1, 0, 0, 0, 0}}, {96, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{95, [numFields]uint16{0, 0, 0, 1, 0}}, {96, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{96, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{95, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{97, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{87, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{29, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{27, [numFields]uint16{0, 0, 0, 1, 0}}, {29, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{99, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{5, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{17, [numFields]uint16{0, 0, 0, 1, 0}}, {47, [numFields]uint16{0, 1, 0, 0, 0}}, {52, [numFields]uint16{0, 1, 0, 0, 0}}, {100, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{59, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{98, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{91, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{85, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{25, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{35, [numFields]uint16{0, 0, 0, 1, 0}}, {40, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{83, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{98, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{14, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{46, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{117, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{92, [numFields]uint16{0, 0, 0, 0, 1}}, {99, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{11, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{75, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{49, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{43, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{76, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{70, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{69, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{9, [numFields]uint16{0, 1, 0, 0, 1}}, {23, [numFields]uint16{0, 0, 0, 0, 1}}, {80, [numFields]uint16{0, 0, 0, 0, 1}}, {86, [numFields]uint16{0, 0, 0, 0, 1}}, {97, [numFields]uint16{0, 0, 0, 0, 1}}, {100, [numFields]uint16{0, 1, 0, 0, 1}}, {101, [numFields]uint16{0, 1, 0, 1, 0}}, {102, [numFields]uint16{0, 1, 0, 0, 1}}, {103, [numFields]uint16{0, 1, 0, 1, 0}}, {108, [numFields]uint16{0, 0, 0, 0, 1}}, {120, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{24, [numFields]uint16{0, 1, 0, 0, 0}}, {33, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{97, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{97, [numFields]uint16{0, 0, 0, 0, 1}}, {107, [numFields]uint16{0, 0, 0, 0, 1}}, {116, [numFields]uint16{0, 0, 0, 0, 1}}},
+		{{4, [numFields]uint16{0, 0, 0, 1, 0}}, {5, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{2, [numFields]uint16{0, 1, 0, 1, 0}}, {10, [numFields]uint16{0, 0, 0, 1, 0}}, {77, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{99, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{42, [numFields]uint16{0, 1, 0, 0, 0}}, {78, [numFields]uint16{0, 1, 0, 1, 0}}, {83, [numFields]uint16{0, 0, 0, 1, 0}}, {97, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{100, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{101, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{102, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{22, [numFields]uint16{0, 0, 0, 1, 0}}, {89, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{103, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{104, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{45, [numFields]uint16{0, 1, 0, 0, 0}}, {104, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{7, [numFields]uint16{0, 1, 0, 0, 0}}, {71, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{27, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{4, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{31, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{36, [numFields]uint16{0, 0, 0, 1, 0}}, {71, [numFields]uint16{0, 0, 0, 1, 0}}, {103, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{111, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{4, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{108, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{92, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{105, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{106, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{120, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{79, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{10, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{104, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{107, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{53, [numFields]uint16{0, 1, 0, 0, 0}}, {80, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{104, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{108, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{53, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{41, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{26, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{111, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{109, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{104, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{43, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{109, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{70, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{110, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{63, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{110, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{111, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{71, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{75, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{117, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{30, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{53, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{93, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{28, [numFields]uint16{0, 0, 0, 1, 0}}, {113, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{104, [numFields]uint16{0, 1, 0, 0, 0}}, {109, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{104, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{6, [numFields]uint16{0, 0, 0, 1, 0}}, {106, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{32, [numFields]uint16{0, 0, 0, 1, 0}}, {48, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{66, [numFields]uint16{0, 1, 0, 1, 0}}, {71, [numFields]uint16{0, 1, 0, 0, 0}}, {72, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{97, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{36, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{18, [numFields]uint16{0, 0, 0, 1, 1}}, {41, [numFields]uint16{0, 0, 0, 0, 1}}, {83, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{68, [numFields]uint16{0, 0, 0, 1, 0}}, {78, [numFields]uint16{0, 0, 0, 1, 0}}, {105, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{26, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{113, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{101, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{1, [numFields]uint16{0, 1, 0, 0, 0}}, {9, [numFields]uint16{0, 1, 0, 0, 0}}, {56, [numFields]uint16{0, 1, 0, 0, 0}}, {103, [numFields]uint16{0, 1, 0, 0, 0}}, {106, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{51, [numFields]uint16{0, 0, 0, 1, 0}}, {71, [numFields]uint16{0, 0, 0, 1, 0}}, {107, [numFields]uint16{0, 1, 0, 0, 0}}, {121, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{92, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{112, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{85, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{113, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{60, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{114, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{42, [numFields]uint16{0, 0, 0, 1, 0}}, {44, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{0, [numFields]uint16{0, 0, 0, 1, 0}}, {20, [numFields]uint16{0, 0, 0, 1, 0}}, {25, [numFields]uint16{0, 0, 0, 1, 0}}, {35, [numFields]uint16{0, 0, 0, 1, 0}}, {38, [numFields]uint16{0, 0, 0, 1, 0}}, {47, [numFields]uint16{0, 0, 0, 1, 0}}, {48, [numFields]uint16{0, 0, 0, 1, 0}}, {59, [numFields]uint16{0, 0, 0, 1, 0}}, {87, [numFields]uint16{0, 0, 0, 1, 0}}, {92, [numFields]uint16{0, 0, 0, 1, 0}}, {119, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{92, [numFields]uint16{0, 1, 0, 0, 0}}, {102, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{65, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{11, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{9, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{114, [numFields]uint16{0, 0, 0, 1, 0}}, {115, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{41, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{122, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{34, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{61, [numFields]uint16{0, 0, 0, 1, 0}}, {111, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{117, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{113, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{114, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{59, [numFields]uint16{0, 0, 0, 1, 0}}, {92, [numFields]uint16{0, 1, 0, 0, 0}}, {95, [numFields]uint16{0, 0, 0, 1, 0}}, {96, [numFields]uint16{0, 0, 0, 1, 0}}, {114, [numFields]uint16{0, 0, 0, 1, 0}}, {115, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{58, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{115, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{116, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{27, [numFields]uint16{0, 1, 0, 0, 0}}, {87, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{45, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{87, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{55, [numFields]uint16{0, 0, 0, 1, 0}}, {121, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{113, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{111, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{93, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{93, [numFields]uint16{0, 1, 0, 0, 0}}, {112, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{92, [numFields]uint16{0, 0, 0, 1, 0}}, {102, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{117, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{116, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{115, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{21, [numFields]uint16{0, 0, 0, 1, 0}}, {118, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{119, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{63, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{106, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{21, [numFields]uint16{0, 0, 0, 1, 0}}, {37, [numFields]uint16{0, 0, 0, 0, 1}}, {42, [numFields]uint16{0, 0, 0, 0, 1}}, {57, [numFields]uint16{0, 1, 0, 1, 1}}, {60, [numFields]uint16{0, 1, 0, 0, 0}}, {68, [numFields]uint16{0, 1, 0, 0, 1}}, {73, [numFields]uint16{0, 1, 0, 0, 1}}, {78, [numFields]uint16{0, 0, 0, 0, 1}}, {83, [numFields]uint16{0, 1, 0, 0, 1}}, {88, [numFields]uint16{0, 1, 0, 0, 1}}, {105, [numFields]uint16{0, 1, 0, 0, 1}}, {118, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{63, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{62, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{120, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{37, [numFields]uint16{0, 0, 0, 1, 0}}, {110, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{120, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{119, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{119, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{121, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{11, [numFields]uint16{0, 0, 0, 1, 0}}, {27, [numFields]uint16{0, 0, 0, 1, 0}}, {50, [numFields]uint16{0, 0, 0, 1, 0}}, {69, [numFields]uint16{0, 0, 0, 1, 0}}, {71, [numFields]uint16{0, 0, 0, 1, 0}}, {87, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{34, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{82, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{121, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{12, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{71, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{119, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{34, [numFields]uint16{0, 1, 0, 1, 0}}, {61, [numFields]uint16{0, 0, 0, 1, 0}}, {122, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{122, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{94, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{65, [numFields]uint16{0, 1, 0, 0, 0}}, {94, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{40, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{103, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{51, [numFields]uint16{0, 1, 0, 1, 0}}, {101, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{122, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{16, [numFields]uint16{0, 0, 0, 1, 0}}, {65, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{123, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{115, [numFields]uint16{0, 1, 0, 1, 0}}},
+		{{13, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{21, [numFields]uint16{0, 0, 0, 1, 0}}, {95, [numFields]uint16{0, 0, 0, 1, 0}}, {118, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{123, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{124, [numFields]uint16{1, 0, 0, 0, 0}}},
+		{{81, [numFields]uint16{0, 0, 0, 1, 0}}, {97, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{124, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{21, [numFields]uint16{0, 0, 0, 1, 0}}, {118, [numFields]uint16{0, 0, 0, 1, 0}}},
+		{{27, [numFields]uint16{0, 0, 0, 1, 0}}, {29, [numFields]uint16{0, 1, 0, 0, 0}}},
+		{{118, [numFields]uint16{0, 0, 0, 1, 0}}},
+	},
+	Lengths: [][numFields]float64{
+		{1, 3, 0, 8, 1},
+		{1, 3, 0, 8, 1},
+		{1, 2, 0, 9, 1},
+		{1, 4, 0, 5, 1},
+		{1, 3, 0, 9, 1},
+		{1, 2, 0, 5, 1},
+		{1, 3, 0, 4, 1},
+		{1, 3, 0, 6, 1},
+		{1, 3, 0, 7, 1},
+		{1, 4, 0, 7, 1},
+		{0, 2, 0, 9, 1},
+		{1, 1, 0, 7, 1},
+		{1, 2, 0, 6, 1},
+		{1, 3, 0, 6, 1},
+		{1, 3, 0, 3, 1},
+		{1, 3, 0, 3, 1},
+		{1, 2, 0, 6, 1},
+		{1, 3, 0, 3, 1},
+		{1, 4, 0, 5, 1},
+		{1, 3, 0, 4, 1},
+		{1, 3, 0, 6, 1},
+		{1, 2, 0, 6, 1},
+		{1, 3, 0, 6, 1},
+		{1, 4, 0, 6, 1},
+		{1, 2, 0, 4, 1},
+		{1, 3, 0, 4, 1},
+		{1, 2, 0, 6, 1},
+		{1, 2, 0, 8, 1},
+		{1, 2, 0, 6, 1},
+		{1, 3, 0, 3, 1},
+		{2, 2, 0, 8, 1},
+		{1, 2, 0, 6, 1},
+		{1, 2, 0, 6, 1},
+		{1, 3, 0, 5, 1},
+		{1, 3, 0, 6, 1},
+		{1, 1, 0, 5, 1},
+		{1, 3, 0, 6, 1},
+		{1, 2, 0, 5, 1},
+		{1, 1, 0, 5, 1},
+		{1, 1, 0, 3, 1},
+		{1, 3, 0, 5, 1},
+		{1, 3, 0, 5, 1},
+		{1, 2, 0, 4, 1},
+		{1, 4, 0, 5, 1},
+		{1, 3, 0, 5, 1},
+		{1, 3, 0, 4, 1},
+		{1, 3, 0, 4, 1},
+		{1, 3, 0, 4, 1},
+		{1, 2, 0, 9, 1},
+		{1, 3, 0, 5, 1},
+		{1, 5, 0, 5, 1},
+		{1, 3, 0, 4, 2},
+		{1, 2, 0, 4, 1},
+		{1, 4, 0, 8, 2},
+		{1, 1, 0, 3, 1},
+		{1, 3, 0, 4, 1},
+		{1, 3, 0, 4, 1},
+		{1, 3, 0, 5, 1},
+		{1, 1, 0, 3, 1},
+		{1, 3, 0, 5, 1},
+		{1, 2, 0, 5, 1},
+		{1, 1, 0, 3, 1},
+		{1, 3, 0, 6, 1},
+		{1, 2, 0, 4, 1},
+		{1, 2, 0, 4, 1},
+		{1, 3, 0, 5, 1},
+		{1, 2, 0, 3, 1},
+		{1, 2, 0, 2, 1},
+		{1, 4, 0, 5, 1},
+		{1, 3, 0, 5, 1},
+		{1, 4, 0, 3, 2},
+		{1, 6, 0, 14, 3},
+		{1, 2, 0, 3, 1},
+		{1, 4, 0, 3, 1},
+		{1, 2, 0, 5, 1},
+		{1, 5, 0, 4, 1},
+		{1, 3, 0, 5, 1},
+		{1, 2, 0, 8, 1},
+		{0, 2, 0, 4, 1},
+		{1, 2, 0, 8, 1},
+		{1, 3, 0, 4, 1},
+		{1, 2, 0, 4, 1},
+		{1, 2, 0, 6, 1},
+		{1, 4, 0, 4, 1},
+		{1, 3, 0, 5, 1},
+		{1, 3, 0, 4, 1},
+		{1, 4, 0, 8, 2},
+		{1, 3, 0, 5, 1},
+		{1, 4, 0, 4, 1},
+		{1, 3, 0, 5, 1},
+		{1, 2, 0, 3, 1},
+		{1, 3, 0, 3, 1},
+		{1, 5, 0, 17, 3},
+		{1, 4, 0, 5, 1},
+		{1, 3, 0, 3, 1},
+		{1, 2, 0, 14, 1},
+		{1, 3, 0, 7, 1},
+		{1, 4, 0, 8, 2},
+		{1, 3, 0, 4, 1},
+		{1, 2, 0, 3, 1},
+		{1, 2, 0, 4, 1},
+		{1, 3, 0, 3, 2},
+		{1, 2, 0, 3, 1},
+		{1, 4, 0, 3, 2},
+		{1, 1, 0, 7, 1},
+		{1, 4, 0, 4, 1},
+		{1, 4, 0, 5, 1},
+		{1, 3, 0, 5, 1},
+		{1, 2, 0, 2, 1},
+		{1, 1, 0, 7, 1},
+		{1, 1, 0, 2, 1},
+		{1, 3, 0, 5, 2},
+		{1, 3, 0, 4, 1},
+		{1, 3, 0, 4, 1},
+		{1, 2, 0, 5, 1},
+		{1, 3, 0, 6, 1},
+		{1, 3, 0, 3, 1},
+		{1, 3, 0, 4, 1},
+		{1, 1, 0, 9, 1},
+		{1, 1, 0, 4, 1},
+		{1, 3, 0, 4, 2},
+		{1, 3, 0, 3, 2},
+		{1, 2, 0, 3, 1},
+		{1, 3, 0, 4, 1},
+		{1, 3, 0, 4, 1},
+	},
+	Average: [numFields]float64{0.992, 2.72, 0, 5.176, 1.112},
+}
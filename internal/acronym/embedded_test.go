@@ -0,0 +1,65 @@
+package acronym
+
+import (
+	"hash/crc32"
+	"reflect"
+	"testing"
+)
+
+// TestEmbeddedTables checks the generated tables are current and hold what
+// parsing the CSV gives
+func TestEmbeddedTables(t *testing.T) {
+	if crc32.Checksum(embeddedCSV, castagnoli) != embeddedChecksum {
+		t.Fatal("embedded_gen.go is out of date with data/acronyms.csv; run go generate ./internal/acronym")
+	}
+
+	compiled, err := NewEmbeddedRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := NewEmbeddedCSVRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(compiled.list, parsed.list) {
+		t.Error("embedded acronyms differ from the CSV")
+	}
+	if !reflect.DeepEqual(compiled.keys, parsed.keys) {
+		t.Error("embedded key trie differs from one built from the CSV")
+	}
+	if want := newSearchIndex(parsed.list); !reflect.DeepEqual(compiled.index, want) {
+		t.Error("embedded search index differs from one built from the CSV")
+	}
+}
+
+// TestEmbeddedCopies checks that changing what one repository returns
+// doesn't change the embedded table another loads
+func TestEmbeddedCopies(t *testing.T) {
+	repo, err := NewEmbeddedRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+	all, err := repo.All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	all[0].Acronym = "CHANGED"
+	repo.list[1].Senses[0].FullForm = "Changed"
+	repo.list[2].Senses[0].Context = append(repo.list[2].Senses[0].Context[:0], "changed")
+
+	fresh, err := NewEmbeddedRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := NewEmbeddedCSVRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fresh.list, parsed.list) {
+		t.Error("changes to a repository's acronyms reached the embedded table")
+	}
+	if repo.list[0].Acronym == "CHANGED" {
+		t.Error("All returned the repository's own list")
+	}
+}
@@ -0,0 +1,52 @@
+package acronym
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by Repository lookups, wrapped with the acronym or query
+// involved. Test for them with errors.Is.
+var (
+	// ErrNotFound means an acronym isn't in the repository
+	ErrNotFound = errors.New("not found")
+	// ErrNoFuzzyMatch means nothing in the repository resembles a query
+	ErrNoFuzzyMatch = errors.New("no fuzzy matches found")
+	// ErrEmptyRepository means there are no acronyms to choose from
+	ErrEmptyRepository = errors.New("no acronyms available")
+)
+
+// ParseError reports a malformed dictionary file and where the problem is.
+// Err is the underlying error, such as a *csv.ParseError's.
+type ParseError struct {
+	Path string
+	// Line and Column are 1-based, or 0 when the position isn't known
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	switch {
+	case e.Line > 0 && e.Column > 0:
+		return fmt.Sprintf("%s:%d:%d: %v", e.Path, e.Line, e.Column, e.Err)
+	case e.Line > 0:
+		return fmt.Sprintf("%s:%d: %v", e.Path, e.Line, e.Err)
+	default:
+		return fmt.Sprintf("%s: %v", e.Path, e.Err)
+	}
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// notFound reports an acronym that isn't in a repository
+func notFound(acronym string) error {
+	return fmt.Errorf("acronym '%s' %w", acronym, ErrNotFound)
+}
+
+// noFuzzyMatch reports a fuzzy query nothing resembles
+func noFuzzyMatch(query string) error {
+	return fmt.Errorf("%w for '%s'", ErrNoFuzzyMatch, query)
+}
@@ -0,0 +1,105 @@
+package acronym
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// SpecialtyRepository narrows another repository to the senses in one
+// specialty. Acronyms with no sense in the specialty are left out entirely.
+type SpecialtyRepository struct {
+	repo      Repository
+	specialty string
+}
+
+// NewSpecialtyRepository filters repo to specialty, which may be a name,
+// label or alias from the taxonomy or any specialty used in a dictionary
+func NewSpecialtyRepository(repo Repository, specialty string) *SpecialtyRepository {
+	return &SpecialtyRepository{repo: repo, specialty: specialty}
+}
+
+// Find looks up an acronym and keeps only its senses in the specialty
+func (s *SpecialtyRepository) Find(acronym string) (*Acronym, error) {
+	a, err := s.repo.Find(acronym)
+	if err != nil {
+		return nil, err
+	}
+	filtered, ok := a.InSpecialty(s.specialty)
+	if !ok {
+		return nil, fmt.Errorf("acronym '%s' %w: it has no %s meaning", acronym, ErrNotFound, SpecialtyLabel(canonicalSpecialty(s.specialty)))
+	}
+	return &filtered, nil
+}
+
+// FindFuzzy ranks every fuzzy match, then keeps the best in the specialty
+func (s *SpecialtyRepository) FindFuzzy(acronym string, maxResults int) ([]Match, error) {
+	if maxResults <= 0 {
+		maxResults = 3
+	}
+	matches, err := s.repo.FindFuzzy(acronym, math.MaxInt)
+	if err != nil {
+		return nil, err
+	}
+
+	results := s.filter(matches, maxResults)
+	if len(results) == 0 {
+		return nil, noFuzzyMatch(acronym)
+	}
+	return results, nil
+}
+
+// Search runs the query over the whole repository and keeps results in the
+// specialty
+func (s *SpecialtyRepository) Search(query string, opts SearchOptions) ([]Match, error) {
+	limit := opts.Limit
+	opts.Limit = 0
+	matches, err := s.repo.Search(query, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.filter(matches, limit), nil
+}
+
+// filter keeps up to limit matches with a sense in the specialty, or all of
+// them when limit is 0
+func (s *SpecialtyRepository) filter(matches []Match, limit int) []Match {
+	results := []Match{}
+	for _, m := range matches {
+		if filtered, ok := m.Acronym.InSpecialty(s.specialty); ok {
+			m.Acronym = filtered
+			results = append(results, m)
+			if len(results) == limit {
+				break
+			}
+		}
+	}
+	return results
+}
+
+// Random returns a random acronym with a sense in the specialty
+func (s *SpecialtyRepository) Random() (*Acronym, error) {
+	all, err := s.All()
+	if err != nil {
+		return nil, err
+	}
+	if len(all) == 0 {
+		return nil, fmt.Errorf("%w in %s", ErrEmptyRepository, SpecialtyLabel(canonicalSpecialty(s.specialty)))
+	}
+	return &all[rand.Intn(len(all))], nil
+}
+
+// All returns the acronyms with a sense in the specialty
+func (s *SpecialtyRepository) All() ([]Acronym, error) {
+	all, err := s.repo.All()
+	if err != nil {
+		return nil, err
+	}
+	var results []Acronym
+	for _, a := range all {
+		if filtered, ok := a.InSpecialty(s.specialty); ok {
+			results = append(results, filtered)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,238 @@
+package acronym
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// FindFuzzy ranks acronyms by how closely they resemble the query, using the
+// repository's Scorer. Equal scores are ordered alphabetically, so results are
+// the same from run to run.
+func (r *MemoryRepository) FindFuzzy(acronym string, maxResults int) ([]Match, error) {
+	if maxResults <= 0 {
+		maxResults = 3
+	}
+
+	query := normalizeKey(acronym)
+	scorer := r.scorer
+	if scorer == nil {
+		scorer = scorers[DefaultScorer]
+	}
+
+	var results []Match
+	add := func(a Acronym, score float64) {
+		if score > 0 {
+			results = append(results, Match{Acronym: a, Type: MatchFuzzy, Score: score})
+		}
+	}
+
+	// Indexed and bounded scorers only need the keys the trie finds within
+	// range; anything else scores every acronym
+	indexed, isIndexed := scorer.(indexedScorer)
+	bounded, isBounded := scorer.(BoundedScorer)
+	switch {
+	case isIndexed && r.keys != nil:
+		// Keys with the query's letters in another order count as one edit,
+		// but can be too far apart for the trie to find
+		anagrams := make(map[int]bool)
+		for _, idx := range r.anagramsOf(query) {
+			anagrams[idx] = true
+			add(r.list[idx], indexed.Score(query, r.list[idx].Acronym))
+		}
+		r.keys.search([]rune(query), maxDistance([]rune(query)), indexed.editCosts(), func(idx int, dist float64) {
+			if !anagrams[idx] {
+				add(r.list[idx], editSimilarity(query, r.list[idx].Acronym, dist))
+			}
+		})
+	case isBounded && r.keys != nil:
+		r.keys.search([]rune(query), float64(bounded.MaxDistance(query)), editCosts{}, func(idx int, _ float64) {
+			add(r.list[idx], scorer.Score(query, r.list[idx].Acronym))
+		})
+	default:
+		for _, a := range r.list {
+			add(a, scorer.Score(query, a.Acronym))
+		}
+	}
+
+	if len(results) == 0 {
+		return nil, noFuzzyMatch(acronym)
+	}
+
+	sortMatches(results)
+	if len(results) > maxResults {
+		results = results[:maxResults]
+	}
+	return results, nil
+}
+
+// anagramsOf returns the positions of the keys with the same letters as key,
+// in any order. The index is built on first use, since only the edit
+// distance scorers need it.
+func (r *MemoryRepository) anagramsOf(key string) []int {
+	r.anagramOnce.Do(func() {
+		r.anagrams = make(map[string][]int)
+		for i, a := range r.list {
+			sorted := sortedLetters(a.Acronym)
+			r.anagrams[sorted] = append(r.anagrams[sorted], i)
+		}
+	})
+	return r.anagrams[sortedLetters(key)]
+}
+
+// sortedLetters returns the letters of key in order, which anagrams share
+func sortedLetters(key string) string {
+	letters := []rune(key)
+	slices.Sort(letters)
+	return string(letters)
+}
+
+// SetScorer changes how FindFuzzy ranks candidates
+func (r *MemoryRepository) SetScorer(s Scorer) {
+	r.scorer = s
+}
+
+// buildFuzzyIndex indexes the loaded keys for FindFuzzy. Loaders call it once
+// every entry has been added.
+func (r *MemoryRepository) buildFuzzyIndex() {
+	r.keys = newKeyTrie(r.list)
+}
+
+// sortMatches orders matches best first, breaking ties alphabetically
+func sortMatches(matches []Match) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Acronym.Acronym < matches[j].Acronym.Acronym
+	})
+}
+
+// maxFuzzyScore keeps fuzzy matches, even of an identical key, below an
+// exact match
+const maxFuzzyScore = 0.99
+
+// maxEdits is the most edits editSimilarity accepts between any two keys
+const maxEdits = 3
+
+// maxDistance is how many edits from query a key can be and still match:
+// one for every three letters, up to maxEdits. A fixed radius would let a
+// three letter query match, and search, nearly every key of three letters
+// or fewer.
+func maxDistance(query []rune) float64 {
+	return float64(min((len(query)+2)/3, maxEdits))
+}
+
+// outOfReach reports whether a candidate's length differs from the query's
+// by more edits than editSimilarity accepts, so scorers can skip computing a
+// distance
+func outOfReach(query, candidate []rune) bool {
+	diff := len(query) - len(candidate)
+	return float64(max(diff, -diff)) > maxDistance(query)
+}
+
+// editSimilarity turns an edit distance between a query and a candidate key
+// into a 0-1 score, or 0 when it is more than maxDistance. Fewer edits always
+// score higher; among keys the same distance away, ones that contain each
+// other or share a prefix score higher, since dropped and added trailing
+// letters are the commonest acronym typos.
+func editSimilarity(query, candidate string, dist float64) float64 {
+	if query == candidate {
+		return maxFuzzyScore
+	}
+	if dist > maxDistance([]rune(query)) {
+		return 0
+	}
+
+	// Distances come in steps of at least a quarter edit, and closeness is
+	// worth less than that, so it only orders keys the same distance away
+	return (maxEdits + 1 - dist + closeness(query, candidate)/5) / (maxEdits + 1.5)
+}
+
+// closeness rates from 0 to 1 how alike two keys look apart from their
+// distance: whether one contains the other, and how many of their first
+// three letters they share
+func closeness(s1, s2 string) float64 {
+	points := 0.0
+	if strings.Contains(s2, s1) || strings.Contains(s1, s2) {
+		points += 4
+	}
+	r1, r2 := []rune(s1), []rune(s2)
+	for i := 0; i < min(len(r1), len(r2), 3) && r1[i] == r2[i]; i++ {
+		points++
+	}
+	return points / 7
+}
+
+// levenshteinRunes calculates the edit distance between two rune slices,
+// keeping only the previous row of the matrix
+func levenshteinRunes(s1, s2 []rune) int {
+	if len(s1) == 0 {
+		return len(s2)
+	}
+	if len(s2) == 0 {
+		return len(s1)
+	}
+
+	prev := make([]int, len(s2)+1)
+	curr := make([]int, len(s2)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(s1); i++ {
+		curr[0] = i
+		for j := 1; j <= len(s2); j++ {
+			cost := 0
+			if s1[i-1] != s2[j-1] {
+				cost = 1
+			}
+
+			curr[j] = min(
+				prev[j]+1,      // deletion
+				curr[j-1]+1,    // insertion
+				prev[j-1]+cost, // substitution
+			)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(s2)]
+}
+
+// weightedDistance is the optimal string alignment distance between s1 and s2:
+// insertions, deletions and swaps of neighbouring letters cost one edit, and a
+// substitution costs subCost, which lets scorers discount likely slips. A nil
+// subCost charges one edit.
+func weightedDistance(s1, s2 []rune, subCost func(a, b rune) float64) float64 {
+	// Rows i-2, i-1 and i of the matrix
+	before := make([]float64, len(s2)+1)
+	prev := make([]float64, len(s2)+1)
+	curr := make([]float64, len(s2)+1)
+	for j := range prev {
+		prev[j] = float64(j)
+	}
+
+	for i := 1; i <= len(s1); i++ {
+		curr[0] = float64(i)
+		for j := 1; j <= len(s2); j++ {
+			cost := 0.0
+			if s1[i-1] != s2[j-1] {
+				cost = 1
+				if subCost != nil {
+					cost = subCost(s1[i-1], s2[j-1])
+				}
+			}
+			curr[j] = min(
+				prev[j]+1,      // deletion
+				curr[j-1]+1,    // insertion
+				prev[j-1]+cost, // substitution
+			)
+			if i > 1 && j > 1 && s1[i-1] == s2[j-2] && s1[i-2] == s2[j-1] {
+				curr[j] = min(curr[j], before[j-2]+1) // transposition
+			}
+		}
+		before, prev, curr = prev, curr, before
+	}
+	return prev[len(s2)]
+}
@@ -0,0 +1,51 @@
+package acronym
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+// TestFindFuzzyMatchesScan checks that searching the key trie finds the same
+// suggestions, with the same scores, as scoring every acronym
+func TestFindFuzzyMatchesScan(t *testing.T) {
+	data, keys := synthesize(5000)
+	repo := newMemoryRepository("test")
+	if err := repo.loadCSV(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	repo.buildFuzzyIndex()
+
+	queries := append(typos(keys, 200), "GBA", "ABG", "BMP", "A", "ZZZZZZZZ")
+	// Reversed keys are anagrams too far apart for the trie
+	for _, key := range keys[:50] {
+		reversed := []rune(key)
+		slices.Reverse(reversed)
+		queries = append(queries, string(reversed))
+	}
+
+	for _, name := range ScorerNames {
+		scorer, _ := ScorerByName(name)
+		for _, query := range queries {
+			repo.SetScorer(scorer)
+			got, _ := repo.FindFuzzy(query, 10)
+			// A ScorerFunc hides the index, so FindFuzzy scans
+			repo.SetScorer(ScorerFunc(scorer.Score))
+			want, _ := repo.FindFuzzy(query, 10)
+
+			if !slices.EqualFunc(got, want, func(a, b Match) bool {
+				return a.Acronym.Acronym == b.Acronym.Acronym && a.Score == b.Score
+			}) {
+				t.Errorf("%s: FindFuzzy(%q) = %v, want %v", name, query, matchKeys(got), matchKeys(want))
+			}
+		}
+	}
+}
+
+func matchKeys(matches []Match) []string {
+	keys := make([]string, len(matches))
+	for i, m := range matches {
+		keys[i] = m.Acronym.Acronym
+	}
+	return keys
+}
@@ -0,0 +1,51 @@
+package acronym
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// normalizeKey returns the lookup key for an acronym: NFKC normalized, so
+// full-width letters pasted from PDFs match their ASCII forms, and upper case
+func normalizeKey(acronym string) string {
+	return strings.ToUpper(norm.NFKC.String(strings.TrimSpace(acronym)))
+}
+
+// stripMarks decomposes text and drops combining marks such as accents
+var stripMarks = transform.Chain(norm.NFKD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// Fold returns s in a form for caseless, accent-insensitive comparison.
+// Compatibility characters are normalized, accents removed and case folded
+// with full Unicode rules, so "ＡＢＧ", "Sjögren" and "STRASSE" fold to the same
+// text as "abg", "sjogren" and "straße".
+func Fold(s string) string {
+	if isASCII(s) {
+		return strings.ToLower(s)
+	}
+	stripped, _, err := transform.String(stripMarks, s)
+	if err != nil {
+		stripped = norm.NFKC.String(s)
+	}
+	return cases.Fold().String(stripped)
+}
+
+// ContainsFold reports whether substr is within s, ignoring case, accents and
+// compatibility forms
+func ContainsFold(s, substr string) bool {
+	return strings.Contains(Fold(s), Fold(substr))
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
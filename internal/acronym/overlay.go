@@ -0,0 +1,176 @@
+package acronym
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// EmbeddedSource is the source name of senses loaded from the built-in dictionary
+const EmbeddedSource = "embedded"
+
+// LocalDictDir is the repo-local folder searched for overlay dictionaries
+const LocalDictDir = ".tmdr"
+
+// Layer names used by NewOverlayRepository
+const (
+	LocalLayer    = "local"
+	UserLayer     = "user"
+	ConfigLayer   = "config"
+	EmbeddedLayer = "embedded"
+)
+
+// DictionaryDir is a folder of overlay dictionaries and the layer it feeds
+type DictionaryDir struct {
+	Layer string
+	Path  string
+}
+
+// NewOverlayRepository stacks every overlay dictionary found in
+// DictionaryDirs, then sources, over the embedded dictionary. Each source is
+// a dictionary file or a folder of them, such as the ones named in the config
+// file.
+//
+// Precedence, highest first:
+//  1. the nearest repo-local .tmdr/ folder
+//  2. the user dictionary folder ($XDG_CONFIG_HOME/tmdr/dicts)
+//  3. sources, where later sources win
+//  4. the embedded dictionary
+//
+// Within a folder, files are applied in name order and later files win.
+// Senses from a higher precedence source rank ahead of lower ones, and a sense
+// whose full form matches a lower precedence sense replaces it.
+func NewOverlayRepository(sources ...string) (*CompositeRepository, error) {
+	var layers []Layer
+	// Later files win, so they go first
+	addFiles := func(layer string, files []string) error {
+		for i := len(files) - 1; i >= 0; i-- {
+			repo, err := NewFileRepository(files[i])
+			if err != nil {
+				return fmt.Errorf("failed to load dictionary %s: %w", files[i], err)
+			}
+			layers = append(layers, Layer{Name: layer, Repo: repo})
+		}
+		return nil
+	}
+
+	for _, dir := range DictionaryDirs() {
+		files, err := dictionaryFiles(dir.Path)
+		if err != nil {
+			return nil, err
+		}
+		if err := addFiles(dir.Layer, files); err != nil {
+			return nil, err
+		}
+	}
+	for i := len(sources) - 1; i >= 0; i-- {
+		files := []string{sources[i]}
+		if isDir(sources[i]) {
+			var err error
+			if files, err = dictionaryFiles(sources[i]); err != nil {
+				return nil, err
+			}
+		}
+		if err := addFiles(ConfigLayer, files); err != nil {
+			return nil, err
+		}
+	}
+
+	embedded, err := NewEmbeddedRepository()
+	if err != nil {
+		return nil, err
+	}
+	layers = append(layers, Layer{Name: EmbeddedLayer, Repo: embedded})
+
+	return NewCompositeRepository(layers...), nil
+}
+
+// DictionaryDirs returns the overlay dictionary folders that exist, highest
+// precedence first
+func DictionaryDirs() []DictionaryDir {
+	var dirs []DictionaryDir
+	if cwd, err := os.Getwd(); err == nil {
+		if dir := findLocalDictDir(cwd); dir != "" {
+			dirs = append(dirs, DictionaryDir{Layer: LocalLayer, Path: dir})
+		}
+	}
+	if dir := UserDictDir(); dir != "" && isDir(dir) {
+		dirs = append(dirs, DictionaryDir{Layer: UserLayer, Path: dir})
+	}
+	return dirs
+}
+
+// UserDictDir returns the user dictionary folder, honouring XDG_CONFIG_HOME
+func UserDictDir() string {
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		configHome = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configHome, "tmdr", "dicts")
+}
+
+// findLocalDictDir walks up from start looking for a .tmdr folder, stopping
+// at the repository root
+func findLocalDictDir(start string) string {
+	dir := start
+	for {
+		candidate := filepath.Join(dir, LocalDictDir)
+		if isDir(candidate) {
+			return candidate
+		}
+		// Don't escape the repository we're working in
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return ""
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// dictionaryFiles lists the dictionary files in dir in name order
+func dictionaryFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dictionary folder %s: %w", dir, err)
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() || !IsDictionaryFile(entry.Name()) {
+			continue
+		}
+		files = append(files, filepath.Join(dir, entry.Name()))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// SourceLabel returns a short description of the layer and file the sense
+// was loaded from, or "" for the embedded dictionary
+func (s Sense) SourceLabel() string {
+	if s.Source == "" || s.Source == EmbeddedSource {
+		return ""
+	}
+	source := s.Source
+	if home, err := os.UserHomeDir(); err == nil && strings.HasPrefix(source, home) {
+		source = "~" + strings.TrimPrefix(source, home)
+	}
+	if s.Layer == "" {
+		return source
+	}
+	return s.Layer + ": " + source
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,114 @@
+package acronym
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// MemoryRepository implements Repository over acronyms held in memory. The
+// CSV, JSON and YAML loaders all produce one.
+type MemoryRepository struct {
+	data   map[string]int
+	list   []Acronym
+	source string
+	scorer Scorer
+	keys   *keyTrie
+
+	anagramOnce sync.Once
+	// anagrams maps the sorted letters of a key to the keys that have them
+	anagrams map[string][]int
+
+	indexOnce sync.Once
+	index     *searchIndex
+}
+
+func newMemoryRepository(source string) *MemoryRepository {
+	return &MemoryRepository{
+		data:   make(map[string]int),
+		list:   []Acronym{},
+		source: source,
+	}
+}
+
+// addEntry adds a dictionary entry as a sense of its acronym
+func (r *MemoryRepository) addEntry(e Entry) {
+	r.add(e.Acronym, Sense{
+		FullForm:    strings.TrimSpace(e.FullForm),
+		Definition:  strings.TrimSpace(e.Definition),
+		Specialty:   specialtyName(e.Specialty),
+		Synonyms:    e.Synonyms,
+		References:  e.References,
+		Context:     e.Context,
+		DoNotUse:    e.DoNotUse,
+		Alternative: strings.TrimSpace(e.Alternative),
+		Source:      r.source,
+	})
+}
+
+// add appends a sense to an acronym, keeping the order rows were read in as
+// the rank. Repeated full forms for the same acronym are ignored.
+func (r *MemoryRepository) add(acronym string, sense Sense) {
+	key := normalizeKey(acronym)
+	idx, exists := r.data[key]
+	if !exists {
+		r.data[key] = len(r.list)
+		r.list = append(r.list, Acronym{Acronym: key, Senses: []Sense{sense}})
+		return
+	}
+
+	if hasFullForm(r.list[idx].Senses, sense.FullForm) {
+		return
+	}
+	r.list[idx].Senses = append(r.list[idx].Senses, sense)
+}
+
+// Find looks up an acronym by its abbreviation and returns all of its senses
+func (r *MemoryRepository) Find(acronym string) (*Acronym, error) {
+	idx, exists := r.data[normalizeKey(acronym)]
+	if !exists {
+		return nil, notFound(acronym)
+	}
+	a := r.list[idx]
+	return &a, nil
+}
+
+// Random returns a copy of a random acronym
+func (r *MemoryRepository) Random() (*Acronym, error) {
+	if len(r.list) == 0 {
+		return nil, ErrEmptyRepository
+	}
+	a := r.list[rand.Intn(len(r.list))]
+	return &a, nil
+}
+
+// All returns a copy of every acronym
+func (r *MemoryRepository) All() ([]Acronym, error) {
+	return slices.Clone(r.list), nil
+}
+
+// cloneAcronyms copies acronyms down to their senses' lists, so a repository
+// over a shared table, such as the embedded one, can't change the table
+func cloneAcronyms(list []Acronym) []Acronym {
+	count := 0
+	for _, a := range list {
+		count += len(a.Senses)
+	}
+
+	// One array holds every sense, each acronym's a full slice of it so an
+	// append can't spill into the next acronym's
+	clone := make([]Acronym, len(list))
+	senses := make([]Sense, 0, count)
+	for i, a := range list {
+		start := len(senses)
+		for _, s := range a.Senses {
+			s.Synonyms = slices.Clone(s.Synonyms)
+			s.References = slices.Clone(s.References)
+			s.Context = slices.Clone(s.Context)
+			senses = append(senses, s)
+		}
+		clone[i] = Acronym{Acronym: a.Acronym, Senses: senses[start:len(senses):len(senses)]}
+	}
+	return clone
+}
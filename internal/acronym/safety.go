@@ -0,0 +1,25 @@
+package acronym
+
+import "fmt"
+
+// SafetyWarning says what to write instead of a do-not-use sense, or returns
+// "" for a sense that is safe to use
+func (s Sense) SafetyWarning() string {
+	if !s.DoNotUse {
+		return ""
+	}
+	if s.Alternative == "" {
+		return "Do not use: this abbreviation is on a do-not-use list"
+	}
+	return fmt.Sprintf("Do not use: write %q instead", s.Alternative)
+}
+
+// Unsafe reports whether any sense of the acronym is on a do-not-use list
+func (a Acronym) Unsafe() bool {
+	for _, s := range a.Senses {
+		if s.DoNotUse {
+			return true
+		}
+	}
+	return false
+}
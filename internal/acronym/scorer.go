@@ -0,0 +1,304 @@
+package acronym
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Scorer rates how closely a candidate acronym resembles a fuzzy query. Both
+// are normalized, upper case keys. Scores range from 0 to 1, where 0 means the
+// candidate is not a match at all.
+type Scorer interface {
+	Score(query, candidate string) float64
+}
+
+// ScorerFunc adapts an ordinary function to the Scorer interface
+type ScorerFunc func(query, candidate string) float64
+
+// Score calls f(query, candidate)
+func (f ScorerFunc) Score(query, candidate string) float64 {
+	return f(query, candidate)
+}
+
+// BoundedScorer is a Scorer that never matches a candidate more than
+// MaxDistance Levenshtein edits from the query. FindFuzzy uses the bound to
+// search the repository's key index instead of scoring every acronym.
+type BoundedScorer interface {
+	Scorer
+	MaxDistance(query string) int
+}
+
+// Names of the built-in scorers
+const (
+	ScorerLevenshtein = "levenshtein"
+	ScorerDamerau     = "damerau"
+	ScorerKeyboard    = "keyboard"
+	ScorerJaroWinkler = "jaro-winkler"
+	ScorerPhonetic    = "phonetic"
+)
+
+// DefaultScorer is used by FindFuzzy unless another scorer is set
+const DefaultScorer = ScorerLevenshtein
+
+// ScorerNames lists the built-in scorers, default first
+var ScorerNames = []string{ScorerLevenshtein, ScorerDamerau, ScorerKeyboard, ScorerJaroWinkler, ScorerPhonetic}
+
+var scorers = map[string]Scorer{
+	ScorerLevenshtein: levenshteinScorer{},
+	ScorerDamerau:     damerauScorer{},
+	ScorerKeyboard:    keyboardScorer{},
+	ScorerJaroWinkler: ScorerFunc(jaroWinklerScore),
+	ScorerPhonetic:    phoneticScorer{},
+}
+
+// ScorerByName returns a built-in scorer
+func ScorerByName(name string) (Scorer, error) {
+	s, ok := scorers[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown scorer '%s' (want one of %s)", name, strings.Join(ScorerNames, ", "))
+	}
+	return s, nil
+}
+
+// levenshteinScorer counts insertions, deletions and substitutions
+type levenshteinScorer struct{}
+
+func (levenshteinScorer) Score(query, candidate string) float64 {
+	q, c := []rune(query), []rune(candidate)
+	if outOfReach(q, c) {
+		return 0
+	}
+	return editScore(query, candidate, float64(levenshteinRunes(q, c)))
+}
+
+func (levenshteinScorer) MaxDistance(query string) int {
+	return int(maxDistance([]rune(query)))
+}
+
+func (levenshteinScorer) editCosts() editCosts {
+	return editCosts{}
+}
+
+// indexedScorer is a Scorer over an edit distance the key index can compute
+// while it searches, so FindFuzzy only scores keys within maxDistance and reuses
+// the distance the index found. Like editScore, its Score must count a key
+// with the query's letters in another order as one edit.
+type indexedScorer interface {
+	Scorer
+	editCosts() editCosts
+}
+
+// editScore scores a candidate dist edits from the query, counting one with
+// the query's letters in another order as a single edit, so GBA suggests
+// ABG whichever edits the scorer counts
+func editScore(query, candidate string, dist float64) float64 {
+	if dist > 1 && isAnagram([]rune(query), []rune(candidate)) {
+		dist = 1
+	}
+	return editSimilarity(query, candidate, dist)
+}
+
+// damerauScorer also counts swapped neighbouring letters as one edit, so ABG
+// is one edit from AGB and two from AGBX
+type damerauScorer struct{}
+
+func (s damerauScorer) Score(query, candidate string) float64 {
+	q, c := []rune(query), []rune(candidate)
+	if outOfReach(q, c) {
+		return 0
+	}
+	return editScore(query, candidate, weightedDistance(q, c, nil))
+}
+
+func (damerauScorer) editCosts() editCosts {
+	return editCosts{transpose: true}
+}
+
+func isAnagram(a, b []rune) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+// keyboardScorer charges half an edit for hitting a key next to the intended
+// one on a QWERTY keyboard, so ABF ranks ABG above ABD
+type keyboardScorer struct{}
+
+func (s keyboardScorer) Score(query, candidate string) float64 {
+	q, c := []rune(query), []rune(candidate)
+	if outOfReach(q, c) {
+		return 0
+	}
+	return editScore(query, candidate, weightedDistance(q, c, s.editCosts().substitute))
+}
+
+func (keyboardScorer) editCosts() editCosts {
+	return editCosts{substitute: keyboardCost, transpose: true}
+}
+
+func keyboardCost(a, b rune) float64 {
+	if keysAdjacent(a, b) {
+		return 0.5
+	}
+	return 1
+}
+
+// qwertyRows are the keyboard rows with each row's offset from the left in key
+// widths, following the stagger of a standard keyboard
+var qwertyRows = []struct {
+	keys   string
+	offset float64
+}{
+	{"1234567890", 0},
+	{"QWERTYUIOP", 0.5},
+	{"ASDFGHJKL", 0.75},
+	{"ZXCVBNM", 1.25},
+}
+
+// adjacentKeys[a][b] is set when keys a and b touch on a QWERTY keyboard
+var adjacentKeys = func() (adjacent [128][128]bool) {
+	type position struct{ x, y float64 }
+	positions := make(map[rune]position)
+	for y, row := range qwertyRows {
+		for x, key := range row.keys {
+			positions[key] = position{x: float64(x) + row.offset, y: float64(y)}
+		}
+	}
+
+	for a, pa := range positions {
+		for b, pb := range positions {
+			dx, dy := math.Abs(pa.x-pb.x), math.Abs(pa.y-pb.y)
+			// Neighbours on the same row are a whole key apart; on the rows
+			// above and below, keys overlapping this one touch it
+			adjacent[a][b] = (dy == 0 && dx == 1) || (dy == 1 && dx < 1)
+		}
+	}
+	return adjacent
+}()
+
+// keysAdjacent reports whether two keys touch on a QWERTY keyboard
+func keysAdjacent(a, b rune) bool {
+	return a < 128 && b < 128 && adjacentKeys[a][b]
+}
+
+// jaroWinklerThreshold is the lowest Jaro-Winkler similarity still reported
+const jaroWinklerThreshold = 0.7
+
+// shortKeyLength is the longest key whose letters Jaro-Winkler matches in
+// any position
+const shortKeyLength = 4
+
+// jaroWinklerScore favours candidates that share letters in roughly the same
+// order, especially at the start. It isn't an edit distance, so the key index can't
+// narrow its candidates and FindFuzzy scores every acronym with it
+func jaroWinklerScore(query, candidate string) float64 {
+	if query == candidate {
+		return maxFuzzyScore
+	}
+	score := jaroWinkler([]rune(query), []rune(candidate))
+	if score < jaroWinklerThreshold {
+		return 0
+	}
+	return min(score, maxFuzzyScore)
+}
+
+func jaroWinkler(s1, s2 []rune) float64 {
+	if len(s1) == 0 || len(s2) == 0 {
+		return 0
+	}
+
+	// Letters match within a window around their position. The usual
+	// window is no letters at all for a three letter key, which would leave
+	// GBA nothing in common with ABG but its B, so short keys match letters
+	// anywhere.
+	longest := max(len(s1), len(s2))
+	window := longest/2 - 1
+	if longest <= shortKeyLength {
+		window = longest
+	}
+	matched1 := make([]bool, len(s1))
+	matched2 := make([]bool, len(s2))
+
+	matches := 0
+	for i, r := range s1 {
+		for j := max(0, i-window); j < min(len(s2), i+window+1); j++ {
+			if !matched2[j] && s2[j] == r {
+				matched1[i], matched2[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// Count matched letters that appear in a different order
+	transpositions, j := 0, 0
+	for i := range s1 {
+		if !matched1[i] {
+			continue
+		}
+		for !matched2[j] {
+			j++
+		}
+		if s1[i] != s2[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(s1)) + m/float64(len(s2)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(len(s1), len(s2), 4) && s1[prefix] == s2[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// letterSounds groups letters whose names sound alike when an acronym is
+// spelled aloud, as in dictated notes where BNP is heard as BMP or DNP
+var letterSounds = func() (sounds [128]int) {
+	for class, letters := range []string{"BCDEGPTVZ", "FSX", "MN", "AHJK", "IY", "QUW"} {
+		for _, r := range letters {
+			sounds[r] = class + 1
+		}
+	}
+	return sounds
+}()
+
+// soundAlike reports whether two letters share a sound class
+func soundAlike(a, b rune) bool {
+	return a < 128 && b < 128 && letterSounds[a] != 0 && letterSounds[a] == letterSounds[b]
+}
+
+// phoneticScorer charges a quarter of an edit for a letter that sounds like
+// the intended one when spelled aloud
+type phoneticScorer struct{}
+
+func (s phoneticScorer) Score(query, candidate string) float64 {
+	q, c := []rune(query), []rune(candidate)
+	if outOfReach(q, c) {
+		return 0
+	}
+	return editScore(query, candidate, weightedDistance(q, c, s.editCosts().substitute))
+}
+
+func (phoneticScorer) editCosts() editCosts {
+	return editCosts{substitute: phoneticCost, transpose: true}
+}
+
+func phoneticCost(a, b rune) float64 {
+	if soundAlike(a, b) {
+		return 0.25
+	}
+	return 1
+}
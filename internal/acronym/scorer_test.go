@@ -0,0 +1,94 @@
+package acronym
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestScorersSuggest checks that every scorer suggests the intended acronym
+// for common typos among the three suggestions lookup shows
+func TestScorersSuggest(t *testing.T) {
+	repo, err := NewEmbeddedRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		query, want string
+		first       bool
+	}{
+		{"cbc", "CBC", true},
+		{"gba", "ABG", false},
+		{"agb", "ABG", false},
+		{"abx", "ABG", false},
+		{"cbx", "CBC", false},
+		{"ekq", "EKG", false},
+		{"hbac1", "HBA1C", false},
+	}
+	for _, name := range ScorerNames {
+		scorer, _ := ScorerByName(name)
+		repo.SetScorer(scorer)
+		for _, tt := range tests {
+			matches, err := repo.FindFuzzy(tt.query, 3)
+			if err != nil {
+				t.Errorf("%s: FindFuzzy(%q): %v", name, tt.query, err)
+				continue
+			}
+			keys := matchKeys(matches)
+			if tt.first && keys[0] != tt.want || !slices.Contains(keys, tt.want) {
+				t.Errorf("%s: FindFuzzy(%q) = %v, want %s", name, tt.query, keys, tt.want)
+			}
+		}
+	}
+}
+
+// TestEditSimilarityRanksByDistance checks that no amount of resemblance
+// lets a key outrank one fewer edits from the query
+func TestEditSimilarityRanksByDistance(t *testing.T) {
+	const query = "ABCDEFG"
+	for dist := 0.25; dist < maxEdits; dist += 0.25 {
+		// Neither contains the other nor shares a letter
+		nearer := editSimilarity(query, "TUVWXYZ", dist)
+		// Contains the query and shares its first letters
+		farther := editSimilarity(query, "ABCDEFGHI", dist+0.25)
+		if nearer <= farther {
+			t.Errorf("%.2f edits scored %.3f, not above %.3f for %.2f edits", dist, nearer, farther, dist+0.25)
+		}
+	}
+
+	if got := editSimilarity(query, query, 0); got != maxFuzzyScore {
+		t.Errorf("identical keys scored %.3f, want %.2f", got, maxFuzzyScore)
+	}
+	// The radius grows by an edit for every three letters in the query
+	tests := []struct {
+		query  string
+		radius float64
+	}{{"A", 1}, {"ABC", 1}, {"ABCD", 2}, {"ABCDEF", 2}, {"ABCDEFG", 3}, {"ABCDEFGHIJ", 3}}
+	for _, tt := range tests {
+		if got := editSimilarity(tt.query, "XYZ", tt.radius); got == 0 {
+			t.Errorf("%.0f edits from %s scored 0", tt.radius, tt.query)
+		}
+		if got := editSimilarity(tt.query, "XYZ", tt.radius+0.25); got != 0 {
+			t.Errorf("%.2f edits from %s scored %.3f, want 0", tt.radius+0.25, tt.query, got)
+		}
+	}
+}
+
+func TestJaroWinklerShortKeys(t *testing.T) {
+	tests := []struct {
+		query, candidate string
+		match            bool
+	}{
+		{"GBA", "ABG", true},
+		{"AGB", "ABG", true},
+		{"ABX", "ABG", true},
+		{"MI", "IM", true},
+		{"XYZ", "ABG", false},
+		{"GCS", "ABG", false},
+	}
+	for _, tt := range tests {
+		if got := jaroWinklerScore(tt.query, tt.candidate); (got > 0) != tt.match {
+			t.Errorf("jaroWinklerScore(%q, %q) = %.3f, want a match: %v", tt.query, tt.candidate, got, tt.match)
+		}
+	}
+}
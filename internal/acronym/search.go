@@ -0,0 +1,348 @@
+package acronym
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SearchOptions tunes a full-text search
+type SearchOptions struct {
+	// Limit caps the number of results; 0 returns every match
+	Limit int
+	// Prefix also matches words that start with the last query word, for
+	// search-as-you-type
+	Prefix bool
+	// Fields restricts the search to some parts of an entry; empty searches
+	// every field
+	Fields []SearchField
+	// Fuzzy also matches words a typo or two away from a query word, at a
+	// lower score
+	Fuzzy bool
+}
+
+// SearchField is a part of an acronym that is indexed separately
+type SearchField int
+
+const (
+	FieldAcronym SearchField = iota
+	FieldFullForm
+	FieldSynonyms
+	FieldDefinition
+	FieldSpecialty
+	numFields
+)
+
+// fieldWeights boosts matches on the acronym and full form over the prose
+var fieldWeights = [numFields]float64{
+	FieldAcronym:    4,
+	FieldFullForm:   2.5,
+	FieldSynonyms:   2,
+	FieldDefinition: 1,
+	FieldSpecialty:  0.5,
+}
+
+// BM25 parameters: k1 controls term frequency saturation, b length normalization
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// stopwords are too common in definitions to help ranking
+var stopwords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "for": true, "from": true, "in": true, "into": true,
+	"is": true, "it": true, "of": true, "on": true, "or": true, "that": true,
+	"the": true, "to": true, "with": true,
+}
+
+// posting records how often a term occurs in each field of one document
+type posting struct {
+	Doc int32
+	TF  [numFields]uint16
+}
+
+// searchIndex is an inverted index over acronyms ranked with BM25F. Terms
+// are sorted, with Postings[i] listing the documents containing Terms[i].
+// Nothing is kept in maps, so the Go tables Compile writes for the index are
+// the same every time.
+type searchIndex struct {
+	docs     []Acronym
+	Terms    []string
+	Postings [][]posting
+	Lengths  [][numFields]float64
+	Average  [numFields]float64
+}
+
+func newSearchIndex(docs []Acronym) *searchIndex {
+	ix := &searchIndex{
+		docs:    docs,
+		Lengths: make([][numFields]float64, len(docs)),
+	}
+	postings := make(map[string][]posting)
+
+	var totals [numFields]float64
+	for doc, a := range docs {
+		counts := make(map[string]*posting)
+		for f, text := range documentFields(a) {
+			tokens := tokenize(text)
+			ix.Lengths[doc][f] = float64(len(tokens))
+			totals[f] += float64(len(tokens))
+			for _, token := range tokens {
+				p, ok := counts[token]
+				if !ok {
+					p = &posting{Doc: int32(doc)}
+					counts[token] = p
+				}
+				p.TF[f]++
+			}
+		}
+		for token, p := range counts {
+			postings[token] = append(postings[token], *p)
+		}
+	}
+
+	if len(docs) > 0 {
+		for f := range totals {
+			ix.Average[f] = totals[f] / float64(len(docs))
+		}
+	}
+
+	ix.Terms = make([]string, 0, len(postings))
+	for term := range postings {
+		ix.Terms = append(ix.Terms, term)
+	}
+	sort.Strings(ix.Terms)
+	ix.Postings = make([][]posting, len(ix.Terms))
+	for i, term := range ix.Terms {
+		ix.Postings[i] = postings[term]
+	}
+	return ix
+}
+
+// postingsFor returns the documents containing term
+func (ix *searchIndex) postingsFor(term string) []posting {
+	i := sort.SearchStrings(ix.Terms, term)
+	if i < len(ix.Terms) && ix.Terms[i] == term {
+		return ix.Postings[i]
+	}
+	return nil
+}
+
+// documentFields returns the text indexed for each field of an acronym
+func documentFields(a Acronym) [numFields]string {
+	var fields [numFields]string
+	fields[FieldAcronym] = a.Acronym
+	var fullForms, synonyms, definitions, specialties []string
+	for _, s := range a.Senses {
+		fullForms = append(fullForms, s.FullForm)
+		synonyms = append(synonyms, s.Synonyms...)
+		definitions = append(definitions, s.Definition)
+		specialties = append(specialties, s.Specialty)
+	}
+	fields[FieldFullForm] = strings.Join(fullForms, " ")
+	fields[FieldSynonyms] = strings.Join(synonyms, " ")
+	fields[FieldDefinition] = strings.Join(definitions, " ")
+	fields[FieldSpecialty] = strings.Join(specialties, " ")
+	return fields
+}
+
+// search ranks the documents matching any query word. Scores are relative to
+// the best result and scaled by the share of query words each result matched,
+// so only a result matching every word exactly scores 1.
+func (ix *searchIndex) search(query string, opts SearchOptions) []Match {
+	tokens := tokenize(query)
+	if len(tokens) == 0 {
+		return []Match{}
+	}
+
+	fields := searchFields(opts.Fields)
+	scores := make(map[int]float64)
+	coverage := make(map[int]float64)
+	for i, token := range tokens {
+		// A document matching several expansions of one word counts once,
+		// with its best expansion
+		best := make(map[int]float64)
+		cover := make(map[int]float64)
+		for _, e := range ix.expand(token, opts, i == len(tokens)-1) {
+			for doc, score := range ix.scoreTerm(e.term, fields) {
+				if score*e.weight > best[doc] {
+					best[doc] = score * e.weight
+				}
+				cover[doc] = max(cover[doc], e.weight)
+			}
+		}
+		for doc, score := range best {
+			scores[doc] += score
+			coverage[doc] += cover[doc]
+		}
+	}
+
+	top := 0.0
+	for _, score := range scores {
+		top = max(top, score)
+	}
+
+	results := make([]Match, 0, len(scores))
+	for doc, score := range scores {
+		score = score / top * coverage[doc] / float64(len(tokens))
+		results = append(results, Match{Acronym: ix.docs[doc], Type: MatchSearch, Score: score})
+	}
+	sortMatches(results)
+
+	if opts.Limit > 0 && len(results) > opts.Limit {
+		results = results[:opts.Limit]
+	}
+	return results
+}
+
+// expansion is an indexed term standing in for a query word. Weight is 1 for
+// the word itself and lower for typo matches.
+type expansion struct {
+	term   string
+	weight float64
+}
+
+// expand returns the indexed terms a query word can match
+func (ix *searchIndex) expand(token string, opts SearchOptions, last bool) []expansion {
+	weights := map[string]float64{token: 1}
+	if opts.Prefix && last {
+		for _, term := range ix.withPrefix(token) {
+			weights[term] = 1
+		}
+	}
+	if opts.Fuzzy {
+		for term, weight := range ix.withTypos(token) {
+			if weight > weights[term] {
+				weights[term] = weight
+			}
+		}
+	}
+
+	expansions := make([]expansion, 0, len(weights))
+	for term, weight := range weights {
+		expansions = append(expansions, expansion{term: term, weight: weight})
+	}
+	return expansions
+}
+
+// withTypos returns indexed terms within a small edit distance of token,
+// weighted by similarity. Short words must match exactly, since a single edit
+// turns most three letter words into other real words.
+func (ix *searchIndex) withTypos(token string) map[string]float64 {
+	maxEdits := 0
+	switch n := utf8.RuneCountInString(token); {
+	case n >= 8:
+		maxEdits = 2
+	case n >= 4:
+		maxEdits = 1
+	}
+	if maxEdits == 0 {
+		return nil
+	}
+
+	tokenRunes := []rune(token)
+	matches := make(map[string]float64)
+	for _, term := range ix.Terms {
+		termLen := utf8.RuneCountInString(term)
+		if diff := termLen - len(tokenRunes); diff > maxEdits || diff < -maxEdits {
+			continue
+		}
+		d := levenshteinRunes(tokenRunes, []rune(term))
+		if d == 0 || d > maxEdits {
+			continue
+		}
+		matches[term] = 1 - float64(d)/float64(max(len(tokenRunes), termLen))
+	}
+	return matches
+}
+
+// searchFields turns a field list into a lookup table, where empty means all
+func searchFields(fields []SearchField) [numFields]bool {
+	var enabled [numFields]bool
+	for _, f := range fields {
+		if f >= 0 && f < numFields {
+			enabled[f] = true
+		}
+	}
+	if len(fields) == 0 {
+		for f := range enabled {
+			enabled[f] = true
+		}
+	}
+	return enabled
+}
+
+// scoreTerm returns the BM25F score of term for each document containing it
+// in one of the enabled fields
+func (ix *searchIndex) scoreTerm(term string, fields [numFields]bool) map[int]float64 {
+	postings := ix.postingsFor(term)
+	if len(postings) == 0 {
+		return nil
+	}
+
+	n := float64(len(ix.docs))
+	df := float64(len(postings))
+	idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+
+	scores := make(map[int]float64, len(postings))
+	for _, p := range postings {
+		tf := 0.0
+		for f := SearchField(0); f < numFields; f++ {
+			if p.TF[f] == 0 || !fields[f] {
+				continue
+			}
+			norm := 1.0
+			if ix.Average[f] > 0 {
+				norm = 1 - bm25B + bm25B*ix.Lengths[p.Doc][f]/ix.Average[f]
+			}
+			tf += fieldWeights[f] * float64(p.TF[f]) / norm
+		}
+		if tf > 0 {
+			scores[int(p.Doc)] = idf * tf / (bm25K1 + tf)
+		}
+	}
+	return scores
+}
+
+// withPrefix returns the indexed terms starting with prefix
+func (ix *searchIndex) withPrefix(prefix string) []string {
+	start := sort.SearchStrings(ix.Terms, prefix)
+	var matches []string
+	for i := start; i < len(ix.Terms) && strings.HasPrefix(ix.Terms[i], prefix); i++ {
+		matches = append(matches, ix.Terms[i])
+	}
+	return matches
+}
+
+// tokenize folds text, splits it into words, drops stopwords and stems what
+// is left
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(Fold(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := words[:0]
+	for _, word := range words {
+		if stopwords[word] {
+			continue
+		}
+		tokens = append(tokens, stem(word))
+	}
+	return tokens
+}
+
+// Search ranks acronyms against a free text query over the acronym, full
+// forms, synonyms, definitions and specialties. Unless the repository came
+// with an index, it is built on first use. A query with no matches returns
+// an empty slice and no error.
+func (r *MemoryRepository) Search(query string, opts SearchOptions) ([]Match, error) {
+	r.indexOnce.Do(func() {
+		if r.index == nil {
+			r.index = newSearchIndex(r.list)
+		}
+	})
+	return r.index.search(query, opts), nil
+}
@@ -0,0 +1,132 @@
+package acronym
+
+import (
+	"slices"
+	"strings"
+)
+
+// Specialty is a category in the taxonomy used to group senses
+type Specialty struct {
+	// Name is the canonical key stored on senses and accepted by --specialty
+	Name  string
+	Label string
+	// Aliases are other names that resolve to this specialty
+	Aliases []string
+}
+
+// Specialties is the taxonomy of categories, in display order
+var Specialties = []Specialty{
+	{Name: "cardiology", Label: "Cardiology", Aliases: []string{"cardiac", "heart", "cardiovascular"}},
+	{Name: "respiratory", Label: "Respiratory", Aliases: []string{"pulmonology", "pulmonary", "lung", "respiratory medicine"}},
+	{Name: "nephrology", Label: "Nephrology", Aliases: []string{"renal", "kidney"}},
+	{Name: "neurology", Label: "Neurology", Aliases: []string{"neuro", "neuroscience"}},
+	{Name: "gastroenterology", Label: "Gastroenterology", Aliases: []string{"gi", "gastro"}},
+	{Name: "endocrinology", Label: "Endocrinology", Aliases: []string{"endocrine", "diabetes"}},
+	{Name: "hematology", Label: "Hematology", Aliases: []string{"haematology", "blood"}},
+	{Name: "infectious-disease", Label: "Infectious Disease", Aliases: []string{"infectious", "infection", "id", "microbiology"}},
+	{Name: "rheumatology", Label: "Rheumatology", Aliases: []string{"rheum", "immunology"}},
+	{Name: "psychiatry", Label: "Psychiatry", Aliases: []string{"psych", "mental health"}},
+	{Name: "pediatrics", Label: "Pediatrics", Aliases: []string{"paediatrics", "peds", "neonatology"}},
+	{Name: "emergency", Label: "Emergency & Critical Care", Aliases: []string{"emergency medicine", "critical care", "resuscitation"}},
+	{Name: "surgery", Label: "Surgery", Aliases: []string{"surgical"}},
+	{Name: "rehabilitation", Label: "Rehabilitation", Aliases: []string{"rehab", "physiotherapy", "physical therapy"}},
+	{Name: "pharmacology", Label: "Pharmacology", Aliases: []string{"pharmacy", "medication", "medications", "drugs"}},
+	{Name: "laboratory", Label: "Laboratory", Aliases: []string{"lab", "pathology"}},
+	{Name: "imaging", Label: "Imaging", Aliases: []string{"radiology"}},
+	{Name: "clinical", Label: "General Clinical", Aliases: []string{"general", "documentation"}},
+	{Name: "admin", Label: "Admin & Regulatory", Aliases: []string{"administrative", "administration", "regulatory", "legal"}},
+	{Name: "units", Label: "Units & Wards", Aliases: []string{"unit", "ward", "wards", "department", "departments"}},
+}
+
+// specialtyNames maps every name, label and alias to its canonical name
+var specialtyNames = func() map[string]string {
+	names := make(map[string]string)
+	for _, s := range Specialties {
+		names[specialtyKey(s.Name)] = s.Name
+		names[specialtyKey(s.Label)] = s.Name
+		for _, alias := range s.Aliases {
+			names[specialtyKey(alias)] = s.Name
+		}
+	}
+	return names
+}()
+
+// specialtyKey folds a specialty name for lookup, so "Critical Care",
+// "critical_care" and "critical-care" are the same
+func specialtyKey(name string) string {
+	return strings.Join(strings.FieldsFunc(Fold(name), func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_' || r == '/'
+	}), "-")
+}
+
+// NormalizeSpecialty returns the canonical name for a specialty, label or
+// alias, and whether it is in the taxonomy
+func NormalizeSpecialty(name string) (string, bool) {
+	canonical, ok := specialtyNames[specialtyKey(name)]
+	return canonical, ok
+}
+
+// canonicalSpecialty returns the canonical name of a specialty in the
+// taxonomy, or a folded form of any other, so custom specialties from user
+// dictionaries still compare equal however they are written
+func canonicalSpecialty(name string) string {
+	if canonical, ok := NormalizeSpecialty(name); ok {
+		return canonical
+	}
+	return specialtyKey(name)
+}
+
+// specialtyName cleans up a specialty read from a dictionary, replacing a
+// known label or alias with its canonical name
+func specialtyName(name string) string {
+	name = strings.TrimSpace(name)
+	if canonical, ok := NormalizeSpecialty(name); ok {
+		return canonical
+	}
+	return name
+}
+
+// SpecialtyLabel returns the display label for a specialty, or the name
+// itself when it is not in the taxonomy
+func SpecialtyLabel(name string) string {
+	for _, s := range Specialties {
+		if s.Name == name {
+			return s.Label
+		}
+	}
+	return name
+}
+
+// SpecialtyNames lists the canonical specialty names in display order
+func SpecialtyNames() []string {
+	names := make([]string, len(Specialties))
+	for i, s := range Specialties {
+		names[i] = s.Name
+	}
+	return names
+}
+
+// Specialties returns the distinct specialties of an acronym's senses, in
+// sense order
+func (a Acronym) Specialties() []string {
+	var specialties []string
+	for _, s := range a.Senses {
+		if s.Specialty != "" && !slices.Contains(specialties, s.Specialty) {
+			specialties = append(specialties, s.Specialty)
+		}
+	}
+	return specialties
+}
+
+// InSpecialty returns a with only the senses in specialty, and whether any
+// were left. The specialty may be a name, label or alias.
+func (a Acronym) InSpecialty(specialty string) (Acronym, bool) {
+	want := canonicalSpecialty(specialty)
+	var senses []Sense
+	for _, s := range a.Senses {
+		if canonicalSpecialty(s.Specialty) == want {
+			senses = append(senses, s)
+		}
+	}
+	return Acronym{Acronym: a.Acronym, Senses: senses}, len(senses) > 0
+}
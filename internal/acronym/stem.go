@@ -0,0 +1,237 @@
+package acronym
+
+// stem reduces an English word to its Porter stem, so that "measuring",
+// "measured" and "measurement" index together. Words containing anything but
+// lower case ASCII letters are returned unchanged.
+func stem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return word
+		}
+	}
+
+	s := &stemmer{b: []byte(word)}
+	s.step1a()
+	s.step1b()
+	s.step1c()
+	s.step2()
+	s.step3()
+	s.step4()
+	s.step5()
+	return string(s.b)
+}
+
+// stemmer holds the word being stemmed. Conditions refer to the stem left
+// once a suffix is removed, which is b[:j].
+type stemmer struct {
+	b []byte
+	j int
+}
+
+// consonant reports whether b[i] is a consonant. Y is a consonant unless it
+// follows one.
+func (s *stemmer) consonant(i int) bool {
+	switch s.b[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !s.consonant(i-1)
+	}
+	return true
+}
+
+// measure counts the vowel-consonant sequences in b[:j]
+func (s *stemmer) measure() int {
+	n, i := 0, 0
+	for i < s.j && s.consonant(i) {
+		i++
+	}
+	for i < s.j {
+		for i < s.j && !s.consonant(i) {
+			i++
+		}
+		if i >= s.j {
+			break
+		}
+		n++
+		for i < s.j && s.consonant(i) {
+			i++
+		}
+	}
+	return n
+}
+
+// vowelInStem reports whether b[:j] contains a vowel
+func (s *stemmer) vowelInStem() bool {
+	for i := 0; i < s.j; i++ {
+		if !s.consonant(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// doubleConsonant reports whether b[:end] ends with a double consonant
+func (s *stemmer) doubleConsonant(end int) bool {
+	return end >= 2 && s.b[end-1] == s.b[end-2] && s.consonant(end-1)
+}
+
+// cvc reports whether b[:end] ends consonant-vowel-consonant where the final
+// consonant is not w, x or y, as in "hop" but not "snow"
+func (s *stemmer) cvc(end int) bool {
+	if end < 3 || !s.consonant(end-1) || s.consonant(end-2) || !s.consonant(end-3) {
+		return false
+	}
+	switch s.b[end-1] {
+	case 'w', 'x', 'y':
+		return false
+	}
+	return true
+}
+
+// ends reports whether the word ends with suffix, setting j to the stem length
+func (s *stemmer) ends(suffix string) bool {
+	if len(s.b) < len(suffix) || string(s.b[len(s.b)-len(suffix):]) != suffix {
+		return false
+	}
+	s.j = len(s.b) - len(suffix)
+	return true
+}
+
+// setTo replaces the suffix after b[:j] with replacement
+func (s *stemmer) setTo(replacement string) {
+	s.b = append(s.b[:s.j], replacement...)
+}
+
+// replace swaps the suffix when the stem's measure is positive
+func (s *stemmer) replace(replacement string) {
+	if s.measure() > 0 {
+		s.setTo(replacement)
+	}
+}
+
+// step1a removes plurals
+func (s *stemmer) step1a() {
+	switch {
+	case s.ends("sses"):
+		s.setTo("ss")
+	case s.ends("ies"):
+		s.setTo("i")
+	case s.ends("ss"):
+	case s.ends("s"):
+		s.setTo("")
+	}
+}
+
+// step1b removes -ed and -ing
+func (s *stemmer) step1b() {
+	if s.ends("eed") {
+		if s.measure() > 0 {
+			s.setTo("ee")
+		}
+		return
+	}
+	if !(s.ends("ed") || s.ends("ing")) || !s.vowelInStem() {
+		return
+	}
+	s.setTo("")
+
+	switch {
+	case s.ends("at"):
+		s.setTo("ate")
+	case s.ends("bl"):
+		s.setTo("ble")
+	case s.ends("iz"):
+		s.setTo("ize")
+	case s.doubleConsonant(len(s.b)):
+		switch s.b[len(s.b)-1] {
+		case 'l', 's', 'z':
+		default:
+			s.b = s.b[:len(s.b)-1]
+		}
+	default:
+		s.j = len(s.b)
+		if s.measure() == 1 && s.cvc(len(s.b)) {
+			s.b = append(s.b, 'e')
+		}
+	}
+}
+
+// step1c turns a terminal y into i when the stem has a vowel
+func (s *stemmer) step1c() {
+	if s.ends("y") && s.vowelInStem() {
+		s.setTo("i")
+	}
+}
+
+var step2Suffixes = []struct{ suffix, replacement string }{
+	{"ational", "ate"}, {"tional", "tion"}, {"enci", "ence"}, {"anci", "ance"},
+	{"izer", "ize"}, {"bli", "ble"}, {"alli", "al"}, {"entli", "ent"},
+	{"eli", "e"}, {"ousli", "ous"}, {"ization", "ize"}, {"ation", "ate"},
+	{"ator", "ate"}, {"alism", "al"}, {"iveness", "ive"}, {"fulness", "ful"},
+	{"ousness", "ous"}, {"aliti", "al"}, {"iviti", "ive"}, {"biliti", "ble"},
+	{"logi", "log"},
+}
+
+// step2 maps double suffixes to single ones
+func (s *stemmer) step2() {
+	for _, r := range step2Suffixes {
+		if s.ends(r.suffix) {
+			s.replace(r.replacement)
+			return
+		}
+	}
+}
+
+var step3Suffixes = []struct{ suffix, replacement string }{
+	{"icate", "ic"}, {"ative", ""}, {"alize", "al"}, {"iciti", "ic"},
+	{"ical", "ic"}, {"ful", ""}, {"ness", ""},
+}
+
+// step3 handles -ic, -full, -ness and friends
+func (s *stemmer) step3() {
+	for _, r := range step3Suffixes {
+		if s.ends(r.suffix) {
+			s.replace(r.replacement)
+			return
+		}
+	}
+}
+
+var step4Suffixes = []string{
+	"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment",
+	"ent", "ion", "ou", "ism", "ate", "iti", "ous", "ive", "ize",
+}
+
+// step4 removes -ant, -ence and friends from longer stems
+func (s *stemmer) step4() {
+	for _, suffix := range step4Suffixes {
+		if !s.ends(suffix) {
+			continue
+		}
+		if suffix == "ion" && (s.j == 0 || (s.b[s.j-1] != 's' && s.b[s.j-1] != 't')) {
+			return
+		}
+		if s.measure() > 1 {
+			s.setTo("")
+		}
+		return
+	}
+}
+
+// step5 removes a final -e and reduces a final -ll
+func (s *stemmer) step5() {
+	if s.ends("e") {
+		m := s.measure()
+		if m > 1 || (m == 1 && !s.cvc(s.j)) {
+			s.setTo("")
+		}
+	}
+	s.j = len(s.b)
+	if s.b[len(s.b)-1] == 'l' && s.doubleConsonant(len(s.b)) && s.measure() > 1 {
+		s.b = s.b[:len(s.b)-1]
+	}
+}
@@ -0,0 +1,232 @@
+package acronym
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Entry is one sense of an acronym as authored in a structured dictionary.
+// JSON and YAML dictionaries are a list of entries.
+type Entry struct {
+	Acronym     string   `json:"acronym" yaml:"acronym"`
+	FullForm    string   `json:"full_form" yaml:"full_form"`
+	Definition  string   `json:"definition,omitempty" yaml:"definition,omitempty"`
+	Specialty   string   `json:"specialty,omitempty" yaml:"specialty,omitempty"`
+	Synonyms    []string `json:"synonyms,omitempty" yaml:"synonyms,omitempty"`
+	References  []string `json:"references,omitempty" yaml:"references,omitempty"`
+	Context     []string `json:"context,omitempty" yaml:"context,omitempty"`
+	DoNotUse    bool     `json:"do_not_use,omitempty" yaml:"do_not_use,omitempty"`
+	Alternative string   `json:"alternative,omitempty" yaml:"alternative,omitempty"`
+}
+
+// NewJSONRepository creates a new repository from a JSON dictionary file
+func NewJSONRepository(path string) (*MemoryRepository, error) {
+	return newStructuredRepository(path, decodeJSON)
+}
+
+// NewYAMLRepository creates a new repository from a YAML dictionary file
+func NewYAMLRepository(path string) (*MemoryRepository, error) {
+	return newStructuredRepository(path, decodeYAML)
+}
+
+// NewFileRepository creates a new repository from a dictionary file, picking
+// the format from its extension
+func NewFileRepository(path string) (*MemoryRepository, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return NewCSVRepository(path)
+	case ".json":
+		return NewJSONRepository(path)
+	case ".yaml", ".yml":
+		return NewYAMLRepository(path)
+	default:
+		return nil, fmt.Errorf("unsupported dictionary format: %s", path)
+	}
+}
+
+// IsDictionaryFile reports whether path has a supported dictionary extension
+func IsDictionaryFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv", ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// position is where an entry starts in a structured dictionary file
+type position struct {
+	line, column int
+}
+
+// errNotAList is reported for a structured dictionary that isn't a list of
+// entries
+var errNotAList = errors.New("expected a list of entries")
+
+// decodeFunc decodes the entries in a structured dictionary file along with
+// where each starts, reporting malformed files as a *ParseError
+type decodeFunc func(path string, data []byte) ([]Entry, []position, error)
+
+func newStructuredRepository(path string, decode decodeFunc) (*MemoryRepository, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open dictionary file: %w", err)
+	}
+
+	entries, positions, err := decode(path, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse dictionary: %w", err)
+	}
+	for i, e := range entries {
+		if err := validateEntry(i, e); err != nil {
+			return nil, &ParseError{Path: path, Line: positions[i].line, Column: positions[i].column, Err: err}
+		}
+	}
+	return newEntryRepository(path, entries), nil
+}
+
+// NewEntryRepository creates a new repository from entries, naming source
+// as where their senses came from
+func NewEntryRepository(source string, entries []Entry) (*MemoryRepository, error) {
+	for i, e := range entries {
+		if err := validateEntry(i, e); err != nil {
+			return nil, err
+		}
+	}
+	return newEntryRepository(source, entries), nil
+}
+
+// newEntryRepository creates a repository from entries that have already
+// been validated
+func newEntryRepository(source string, entries []Entry) *MemoryRepository {
+	repo := newMemoryRepository(source)
+	for _, e := range entries {
+		repo.addEntry(e)
+	}
+	repo.buildFuzzyIndex()
+	return repo
+}
+
+// validateEntry checks the fields every entry needs
+func validateEntry(i int, e Entry) error {
+	if strings.TrimSpace(e.Acronym) == "" {
+		return fmt.Errorf("entry %d: missing acronym", i+1)
+	}
+	if strings.TrimSpace(e.FullForm) == "" {
+		return fmt.Errorf("entry %d (%s): missing full_form", i+1, e.Acronym)
+	}
+	return nil
+}
+
+// decodeJSON decodes a JSON list of entries one at a time, so each entry's
+// position is known
+func decodeJSON(path string, data []byte) ([]Entry, []position, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return nil, nil, jsonError(path, data, err, 0)
+	}
+
+	var entries []Entry
+	var positions []position
+	for dec.More() {
+		start := skipSeparators(data, int(dec.InputOffset()))
+		var e Entry
+		if err := dec.Decode(&e); err != nil {
+			return nil, nil, jsonError(path, data, err, start)
+		}
+		entries = append(entries, e)
+		positions = append(positions, positionAt(data, start))
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, nil, jsonError(path, data, err, len(data))
+	}
+	return entries, positions, nil
+}
+
+// jsonError reports a JSON decoding error at the offending character, or at
+// offset for errors that don't say where they are
+func jsonError(path string, data []byte, err error, offset int) *ParseError {
+	var syntaxErr *json.SyntaxError
+	switch {
+	case err == nil:
+		err = errNotAList
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		err = errors.New("unexpected end of file")
+	case errors.As(err, &syntaxErr):
+		offset = int(syntaxErr.Offset) - 1
+	}
+	pos := positionAt(data, skipSeparators(data, max(offset, 0)))
+	return &ParseError{Path: path, Line: pos.line, Column: pos.column, Err: err}
+}
+
+// skipSeparators skips whitespace and commas from offset
+func skipSeparators(data []byte, offset int) int {
+	for offset < len(data) && strings.IndexByte(" \t\r\n,", data[offset]) >= 0 {
+		offset++
+	}
+	return offset
+}
+
+// positionAt returns the 1-based line and byte column of offset in data
+func positionAt(data []byte, offset int) position {
+	offset = min(offset, len(data))
+	line := bytes.Count(data[:offset], []byte("\n")) + 1
+	column := offset - bytes.LastIndexByte(data[:offset], '\n')
+	return position{line: line, column: column}
+}
+
+// decodeYAML decodes a YAML list of entries through its node tree, which
+// records where each entry starts. An empty file is an empty dictionary.
+func decodeYAML(path string, data []byte) ([]Entry, []position, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, yamlError(path, err, position{})
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil, nil
+	}
+
+	list := doc.Content[0]
+	if list.Kind != yaml.SequenceNode {
+		return nil, nil, &ParseError{Path: path, Line: list.Line, Column: list.Column, Err: errNotAList}
+	}
+
+	entries := make([]Entry, len(list.Content))
+	positions := make([]position, len(list.Content))
+	for i, node := range list.Content {
+		positions[i] = position{line: node.Line, column: node.Column}
+		if err := node.Decode(&entries[i]); err != nil {
+			return nil, nil, yamlError(path, err, positions[i])
+		}
+	}
+	return entries, positions, nil
+}
+
+// yamlLine finds the line yaml.v3 puts in its error messages
+var yamlLine = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// yamlError reports a YAML error at the line its message gives, or at pos
+func yamlError(path string, err error, pos position) *ParseError {
+	message := err.Error()
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) && len(typeErr.Errors) > 0 {
+		message = typeErr.Errors[0]
+	}
+	if m := yamlLine.FindStringSubmatch(message); m != nil {
+		line, _ := strconv.Atoi(m[1])
+		if line != pos.line {
+			pos = position{line: line}
+		}
+		message = m[2]
+	}
+	return &ParseError{Path: path, Line: pos.line, Column: pos.column, Err: errors.New(message)}
+}
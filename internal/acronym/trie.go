@@ -0,0 +1,179 @@
+package acronym
+
+import "sort"
+
+// keyTrie indexes acronym keys letter by letter for fuzzy lookups. A search
+// walks the trie carrying one row of the edit distance matrix per letter, so
+// keys sharing a prefix share its work, and a branch is abandoned as soon as
+// every cell in its row exceeds the search radius.
+//
+// Nodes are stored breadth first in flat slices, with each node's children
+// next to each other, which keeps a walk over a large dictionary cache
+// friendly, and lets Compile write the trie out as Go tables.
+type keyTrie struct {
+	Nodes []trieNode
+	// Letters[i] is the letter leading into Nodes[i]
+	Letters []rune
+	// Depth is the length of the longest key, in runes
+	Depth int
+}
+
+type trieNode struct {
+	// Idx is the position of the key ending here, or -1
+	Idx int32
+	// First and Last bound the node's children in Nodes
+	First, Last int32
+}
+
+// newKeyTrie indexes the keys of acronyms, remembering each one's position
+func newKeyTrie(acronyms []Acronym) *keyTrie {
+	// Build a pointer trie, then flatten it
+	type buildNode struct {
+		idx      int32
+		letters  []rune
+		children []*buildNode
+	}
+	root := &buildNode{idx: -1}
+	depth := 0
+	for i, a := range acronyms {
+		key := []rune(a.Acronym)
+		depth = max(depth, len(key))
+		node := root
+		for _, r := range key {
+			j := sort.Search(len(node.letters), func(j int) bool { return node.letters[j] >= r })
+			if j == len(node.letters) || node.letters[j] != r {
+				node.letters = append(node.letters, 0)
+				copy(node.letters[j+1:], node.letters[j:])
+				node.letters[j] = r
+				node.children = append(node.children, nil)
+				copy(node.children[j+1:], node.children[j:])
+				node.children[j] = &buildNode{idx: -1}
+			}
+			node = node.children[j]
+		}
+		if node.idx < 0 {
+			node.idx = int32(i)
+		}
+	}
+
+	t := &keyTrie{Depth: depth}
+	t.Nodes = append(t.Nodes, trieNode{Idx: root.idx})
+	t.Letters = append(t.Letters, 0)
+	queue := []*buildNode{root}
+	for i := 0; i < len(queue); i++ {
+		node := queue[i]
+		t.Nodes[i].First = int32(len(t.Nodes))
+		for j, child := range node.children {
+			t.Nodes = append(t.Nodes, trieNode{Idx: child.idx})
+			t.Letters = append(t.Letters, node.letters[j])
+			queue = append(queue, child)
+		}
+		t.Nodes[i].Last = int32(len(t.Nodes))
+	}
+	return t
+}
+
+// editCosts prices the edits a search counts. The zero value counts plain
+// Levenshtein distance.
+type editCosts struct {
+	// substitute prices replacing one letter with another, at most one edit;
+	// nil charges every substitution one edit
+	substitute func(a, b rune) float64
+	// transpose counts swapping neighbouring letters as one edit
+	transpose bool
+}
+
+// search calls fn with the index and distance of every key within radius
+// edits of query, priced by costs
+func (t *keyTrie) search(query []rune, radius float64, costs editCosts, fn func(idx int, dist float64)) {
+	if len(t.Nodes) == 0 {
+		return
+	}
+
+	s := trieSearch{trie: t, query: query, radius: radius, costs: costs, fn: fn}
+	s.rows = make([][]float64, t.Depth+1)
+	for i := range s.rows {
+		s.rows[i] = make([]float64, len(query)+1)
+	}
+	for j := range s.rows[0] {
+		s.rows[0][j] = float64(j)
+	}
+
+	root := t.Nodes[0]
+	if float64(len(query)) <= radius && root.Idx >= 0 {
+		fn(int(root.Idx), float64(len(query)))
+	}
+	// An empty dictionary, such as a CSV file with only a header, has no
+	// rows to walk
+	if root.First < root.Last {
+		s.walk(0, 1)
+	}
+}
+
+// trieSearch holds the state of one search, with a reusable matrix row for
+// each depth of the trie
+type trieSearch struct {
+	trie   *keyTrie
+	query  []rune
+	radius float64
+	costs  editCosts
+	fn     func(idx int, dist float64)
+	rows   [][]float64
+}
+
+// walk fills in the rows for the children of parent, which sit at depth. A
+// branch is pruned once every cell in its row exceeds the radius: edits only
+// add to a row's minimum, and with no substitution or swap costing more than
+// one edit, a swap can't bring a later row back under it either.
+func (s *trieSearch) walk(parent int32, depth int) {
+	prev, row := s.rows[depth-1], s.rows[depth]
+	substitute, transpose := s.costs.substitute, s.costs.transpose && depth > 1
+	var before []float64
+	var previous rune
+	if transpose {
+		// The parent's letter is the one before this in the key
+		before, previous = s.rows[depth-2], s.trie.Letters[parent]
+	}
+
+	p := s.trie.Nodes[parent]
+	for n := p.First; n < p.Last; n++ {
+		letter := s.trie.Letters[n]
+		row[0] = float64(depth)
+		best := row[0]
+		for j, q := range s.query {
+			cost := 0.0
+			if q != letter {
+				cost = 1
+				if substitute != nil {
+					cost = substitute(letter, q)
+				}
+			}
+			// Plain comparisons: the builtin min handles NaN, which costs
+			// time in the innermost loop
+			dist := prev[j] + cost
+			if d := prev[j+1] + 1; d < dist {
+				dist = d
+			}
+			if d := row[j] + 1; d < dist {
+				dist = d
+			}
+			if transpose && j > 0 && letter == s.query[j-1] && previous == q {
+				if d := before[j-1] + 1; d < dist {
+					dist = d
+				}
+			}
+			row[j+1] = dist
+			if dist < best {
+				best = dist
+			}
+		}
+
+		node := s.trie.Nodes[n]
+		if dist := row[len(s.query)]; dist <= s.radius && node.Idx >= 0 {
+			s.fn(int(node.Idx), dist)
+		}
+		if best <= s.radius && node.First < node.Last {
+			s.walk(n, depth+1)
+		}
+	}
+}
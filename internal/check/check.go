@@ -0,0 +1,367 @@
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+)
+
+// Finding is a do-not-use abbreviation or dose notation found in text
+type Finding struct {
+	// Line and Column are 1-based; Column counts bytes
+	Line   int
+	Column int
+	// Term is the text as written
+	Term string
+	// Alternatives are what to write instead
+	Alternatives []string
+	Message      string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%d:%d: %s", f.Line, f.Column, f.Message)
+}
+
+// Options tunes the checks
+type Options struct {
+	// Ignore lists abbreviations not to report, such as OS in a codebase
+	// where it only ever means operating system
+	Ignore []string
+}
+
+// Checker finds do-not-use abbreviations from a repository in text
+type Checker struct {
+	flagged map[string]acronym.Acronym
+	// known holds every abbreviation in the repository, flagged or not
+	known map[string]bool
+}
+
+// New prepares a Checker for every acronym in repo with a do-not-use sense
+func New(repo acronym.Repository, opts Options) (*Checker, error) {
+	all, err := repo.All()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load acronyms: %w", err)
+	}
+
+	ignored := make(map[string]bool)
+	for _, term := range opts.Ignore {
+		ignored[strings.ToUpper(strings.TrimSpace(term))] = true
+	}
+
+	c := &Checker{flagged: make(map[string]acronym.Acronym), known: make(map[string]bool, len(all))}
+	for _, a := range all {
+		key := strings.ToUpper(a.Acronym)
+		c.known[key] = true
+		if a.Unsafe() && !ignored[key] {
+			c.flagged[key] = a
+		}
+	}
+	return c, nil
+}
+
+// Text reports every do-not-use abbreviation and dose notation in text.
+// Abbreviations are matched when written with at least two capitals (QD,
+// MSO4), with periods (q.d.) or after a number (10 u, 5cc), so ordinary
+// words such as "as" are left alone. Next to other words in capitals, as in
+// USE AS DIRECTED, capitals alone aren't enough: the words around it must
+// point to the do-not-use meaning.
+func (c *Checker) Text(text string) []Finding {
+	var findings []Finding
+	for i, line := range strings.Split(text, "\n") {
+		findings = append(findings, c.line(line, i+1)...)
+		findings = append(findings, checkDoses(line, i+1)...)
+	}
+	sortFindings(findings)
+	return findings
+}
+
+// contextWindow is how many words either side of an abbreviation, within
+// its sentence, are read to tell which meaning was intended
+const contextWindow = 6
+
+// minConfidence is the share of the context's evidence one meaning needs for
+// an abbreviation to be taken to mean only that
+const minConfidence = 0.6
+
+// line checks the words on one line
+func (c *Checker) line(line string, lineNo int) []Finding {
+	var findings []Finding
+	ws := words(line)
+	afterNumber := false
+	for i, w := range ws {
+		number, term := splitNumber(w.text)
+		if term == "" {
+			afterNumber = number != ""
+			continue
+		}
+
+		dotted := strings.Contains(term, ".")
+		key := strings.ToUpper(strings.ReplaceAll(term, ".", ""))
+		a, ok := c.flagged[key]
+		written := dotted || afterNumber || number != ""
+		if ok && (written || upperCount(term) >= 2) {
+			// In text written in capitals, capitals don't set an
+			// abbreviation apart from words such as AS in USE AS DIRECTED
+			shouted := !written && c.inCapitals(ws, i)
+			if f, unsafe := finding(a, term, window(ws, i), shouted); unsafe {
+				f.Line = lineNo
+				f.Column = w.start + len(number) + 1
+				findings = append(findings, f)
+			}
+		}
+		afterNumber = false
+	}
+	return findings
+}
+
+// window returns the words around ws[i] that are in the same sentence, up
+// to contextWindow on each side
+func window(ws []word, i int) string {
+	start := i
+	for start > 0 && i-start < contextWindow && !ws[start-1].sentenceEnd {
+		start--
+	}
+	end := i
+	for end < len(ws)-1 && end-i < contextWindow && !ws[end].sentenceEnd {
+		end++
+	}
+
+	texts := make([]string, 0, end-start+1)
+	for _, w := range ws[start : end+1] {
+		texts = append(texts, w.text)
+	}
+	return strings.Join(texts, " ")
+}
+
+// inCapitals reports whether a word next to ws[i] in its sentence is written
+// in capitals, as in USE AS DIRECTED or OS X. Other abbreviations, as in OS
+// TID, and words without letters, such as doses, don't count.
+func (c *Checker) inCapitals(ws []word, i int) bool {
+	if i > 0 && !ws[i-1].sentenceEnd && c.capitalized(ws[i-1].text) {
+		return true
+	}
+	return i+1 < len(ws) && !ws[i].sentenceEnd && c.capitalized(ws[i+1].text)
+}
+
+// capitalized reports whether w is a word written in capitals that isn't an
+// abbreviation in the repository
+func (c *Checker) capitalized(w string) bool {
+	_, term := splitNumber(w)
+	key := strings.ReplaceAll(term, ".", "")
+	return hasLetter(term) && strings.ToUpper(term) == term && !c.known[key]
+}
+
+// finding describes a flagged abbreviation, using the words around it to work
+// out which meaning was intended. It is reported when one of its do-not-use
+// meanings is clearly meant or has support nearby, or when nothing nearby
+// points to any meaning. In text written in capitals that last case isn't
+// reported, as the term may be an ordinary word.
+func finding(a acronym.Acronym, term, context string, shouted bool) (Finding, bool) {
+	ranked := a.Disambiguate(context)
+
+	var unsafe []acronym.Sense
+	definite := ranked[0].Score >= minConfidence
+	switch {
+	case definite:
+		if ranked[0].Sense.DoNotUse {
+			unsafe = append(unsafe, ranked[0].Sense)
+		}
+	case ranked[0].Score > 0:
+		// The evidence is split, so any unsafe meaning it supports is reported
+		for _, r := range ranked {
+			if r.Score > 0 && r.Sense.DoNotUse {
+				unsafe = append(unsafe, r.Sense)
+			}
+		}
+	case !shouted:
+		for _, s := range a.Senses {
+			if s.DoNotUse {
+				unsafe = append(unsafe, s)
+			}
+		}
+	}
+	if len(unsafe) == 0 {
+		return Finding{}, false
+	}
+
+	var meanings, alternatives []string
+	for _, s := range unsafe {
+		meanings = append(meanings, s.FullForm)
+		if s.Alternative != "" {
+			alternatives = append(alternatives, s.Alternative)
+		}
+	}
+
+	var message string
+	if definite || len(unsafe) == len(a.Senses) {
+		message = fmt.Sprintf("%s (%s) is on the do-not-use list", term, strings.Join(meanings, " or "))
+	} else {
+		message = fmt.Sprintf("%s may mean %s, which is on the do-not-use list", term, strings.Join(meanings, " or "))
+	}
+	if len(alternatives) > 0 {
+		message += fmt.Sprintf("; write %s instead", quoteAll(alternatives))
+	}
+	return Finding{Term: term, Alternatives: alternatives, Message: message}, true
+}
+
+// word is a run of letters, digits, periods and slashes on a line
+type word struct {
+	text  string
+	start int
+	// sentenceEnd is set for the last word of a sentence
+	sentenceEnd bool
+}
+
+// words splits a line into words, trimming periods and slashes that don't
+// join two word characters, so "q.d." becomes "q.d" and "D/C," becomes "D/C"
+func words(line string) []word {
+	var found []word
+	for i := 0; i < len(line); {
+		r, size := utf8.DecodeRuneInString(line[i:])
+		if !isWordRune(r) {
+			i += size
+			continue
+		}
+		start := i
+		for i < len(line) {
+			r, size = utf8.DecodeRuneInString(line[i:])
+			if isWordRune(r) {
+				i += size
+				continue
+			}
+			if (r == '.' || r == '/') && i+size < len(line) {
+				if next, _ := utf8.DecodeRuneInString(line[i+size:]); isWordRune(next) {
+					i += size
+					continue
+				}
+			}
+			break
+		}
+		found = append(found, word{text: line[start:i], start: start, sentenceEnd: endsSentence(line, start, i)})
+	}
+	return found
+}
+
+// endsSentence reports whether the word line[start:end] ends a sentence: it
+// is followed by a full stop, question mark or exclamation mark and then a
+// space, and isn't an abbreviation written with periods such as q.d.
+func endsSentence(line string, start, end int) bool {
+	if end >= len(line) || !strings.ContainsRune(".?!", rune(line[end])) {
+		return false
+	}
+	if strings.Contains(line[start:end], ".") {
+		return false
+	}
+	return end+1 == len(line) || line[end+1] == ' ' || line[end+1] == '\t'
+}
+
+// splitNumber splits a leading quantity such as "10" or "2.5" off a word
+func splitNumber(w string) (string, string) {
+	i := 0
+	for i < len(w) && (w[i] >= '0' && w[i] <= '9' || w[i] == '.' && i > 0) {
+		i++
+	}
+	number := strings.TrimSuffix(w[:i], ".")
+	return number, w[len(number):]
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func hasLetter(s string) bool {
+	return strings.IndexFunc(s, unicode.IsLetter) >= 0
+}
+
+func upperCount(s string) int {
+	n := 0
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// doseUnits are the units after which dose notation is checked
+const doseUnits = `(?:mg|mcg|micrograms?|milligrams?|g|grams?|kg|ml|l|units?|u|iu|meq|mmol|tablets?|tabs?|drops?)`
+
+// doseRules catch notation that leads to tenfold dosing errors. The first
+// group of each pattern is the notation to report.
+var doseRules = []struct {
+	pattern *regexp.Regexp
+	// fix returns the replacement for a match and the reason to give
+	fix func(match []string) (string, string)
+}{
+	{
+		// 1.0 mg is read as 10 mg when the point is missed
+		pattern: regexp.MustCompile(`(?i)(?:^|[^\d.])((\d+)\.0+(\s*` + doseUnits + `))\b`),
+		fix: func(m []string) (string, string) {
+			return m[2] + m[3], "trailing zero"
+		},
+	},
+	{
+		// .5 mg is read as 5 mg when the point is missed
+		pattern: regexp.MustCompile(`(?i)(?:^|[^\w.])((\.\d+)(\s*` + doseUnits + `))\b`),
+		fix: func(m []string) (string, string) {
+			return "0" + m[2] + m[3], "missing leading zero"
+		},
+	},
+	{
+		// µg is read as mg
+		pattern: regexp.MustCompile(`([µμ]g)\b`),
+		fix: func(m []string) (string, string) {
+			return "mcg", "µg is mistaken for mg"
+		},
+	},
+}
+
+// checkDoses reports dose notation that is on the do-not-use list
+func checkDoses(line string, lineNo int) []Finding {
+	var findings []Finding
+	for _, rule := range doseRules {
+		for _, idx := range rule.pattern.FindAllStringSubmatchIndex(line, -1) {
+			match := make([]string, len(idx)/2)
+			for i := range match {
+				if idx[2*i] >= 0 {
+					match[i] = line[idx[2*i]:idx[2*i+1]]
+				}
+			}
+
+			term := match[1]
+			alternative, reason := rule.fix(match)
+			findings = append(findings, Finding{
+				Line:         lineNo,
+				Column:       idx[2] + 1,
+				Term:         term,
+				Alternatives: []string{alternative},
+				Message:      fmt.Sprintf("%s: %q is on the do-not-use list; write %q instead", reason, term, alternative),
+			})
+		}
+	}
+	return findings
+}
+
+// sortFindings orders findings by position
+func sortFindings(findings []Finding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Line != findings[j].Line {
+			return findings[i].Line < findings[j].Line
+		}
+		return findings[i].Column < findings[j].Column
+	})
+}
+
+// quoteAll quotes each alternative and joins them with "or"
+func quoteAll(alternatives []string) string {
+	quoted := make([]string, len(alternatives))
+	for i, a := range alternatives {
+		quoted[i] = fmt.Sprintf("%q", a)
+	}
+	return strings.Join(quoted, " or ")
+}
@@ -0,0 +1,90 @@
+package check
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+)
+
+func newChecker(t *testing.T, opts Options) *Checker {
+	t.Helper()
+	repo, err := acronym.NewEmbeddedRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := New(repo, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// terms lists the findings as column:term pairs
+func terms(findings []Finding) []string {
+	var found []string
+	for _, f := range findings {
+		found = append(found, fmt.Sprintf("%d:%s", f.Column, f.Term))
+	}
+	return found
+}
+
+func TestText(t *testing.T) {
+	c := newChecker(t, Options{})
+
+	tests := []struct {
+		name, text string
+		want       []string
+	}{
+		{"once daily", "Take 5 mg QD.", []string{"11:QD"}},
+		{"with periods", "Take 5 mg q.d. with food", []string{"11:q.d"}},
+		{"after a number", "Give 10 u of insulin", []string{"9:u"}},
+		{"attached to a number", "Give 5cc now", []string{"7:cc"}},
+		{"ordinary words", "Use as directed and as needed", nil},
+		{"morphine", "MS 5 mg IV for pain", []string{"1:MS"}},
+		{"multiple sclerosis", "MS with MRI lesions", nil},
+		{"mixed context", "MS 5 mg IV. MS with MRI lesions.", []string{"1:MS"}},
+		{"mixed context reversed", "MS with MRI lesions. MS 5 mg IV.", []string{"22:MS"}},
+		{"split evidence", "MS 5 mg IV and MRI lesions", []string{"1:MS"}},
+		{"capitals", "USE AS DIRECTED", nil},
+		{"product name", "Runs on OS X and Linux", nil},
+		{"capitals with a cue", "1 DROP OS FOR THE LEFT EYE", []string{"8:OS"}},
+		{"next to another abbreviation", "1 drop OS TID", []string{"8:OS"}},
+		{"capitals after a number", "INSTILL 1 DROP AS 2 AS NEEDED", []string{"21:AS"}},
+		{"trailing zero", "Give 1.0 mg", []string{"6:1.0 mg"}},
+		{"leading zero", "Give .5 mg", []string{"6:.5 mg"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := terms(c.Text(tt.text))
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("Text(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessages(t *testing.T) {
+	c := newChecker(t, Options{})
+
+	tests := []struct{ text, want string }{
+		{"MS 5 mg IV for pain", "MS (Morphine Sulfate) is on the do-not-use list"},
+		{"MS 5 mg IV and MRI lesions", "MS may mean Morphine Sulfate, which is on the do-not-use list"},
+		{"Review the MS", "MS may mean Morphine Sulfate, which is on the do-not-use list"},
+		{"Take 5 mg QD", `write "daily" instead`},
+	}
+	for _, tt := range tests {
+		findings := c.Text(tt.text)
+		if len(findings) != 1 || !strings.Contains(findings[0].Message, tt.want) {
+			t.Errorf("Text(%q) = %v, want one finding saying %q", tt.text, findings, tt.want)
+		}
+	}
+}
+
+func TestIgnore(t *testing.T) {
+	c := newChecker(t, Options{Ignore: []string{" os", "QD"}})
+	if findings := c.Text("Boot the OS, then take 5 mg QD and 10 u"); len(findings) != 1 || findings[0].Term != "u" {
+		t.Errorf("ignored abbreviations were reported: %v", findings)
+	}
+}
@@ -0,0 +1,239 @@
+// Package config reads tmdr's settings from a YAML file in the tmdr config
+// folder, with TMDR_* environment variables taking precedence over the file
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/output"
+	"github.com/anthonylangham/tmdr/internal/theme"
+	"github.com/anthonylangham/tmdr/internal/update"
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the config file in the tmdr config folder
+const FileName = "config.yaml"
+
+// PathEnv names the environment variable that moves the config file
+const PathEnv = "TMDR_CONFIG"
+
+// Config is every setting. Load fills in defaults for anything the file and
+// environment leave out.
+type Config struct {
+	// Dictionaries are extra dictionary files or folders, ranked below the
+	// user and repo-local dictionaries
+	Dictionaries []string `yaml:"dictionaries"`
+	// Format is the output format of lookups and searches
+	Format string `yaml:"format"`
+	Fuzzy  Fuzzy  `yaml:"fuzzy"`
+	// Theme names the Terminal App's colors, one of theme.Names
+	Theme  string `yaml:"theme"`
+	TUI    TUI    `yaml:"tui"`
+	Update Update `yaml:"update"`
+}
+
+// Fuzzy tunes suggestions for misspelled acronyms
+type Fuzzy struct {
+	// Scorer is one of acronym.ScorerNames
+	Scorer string `yaml:"scorer"`
+	// Suggestions is how many suggestions a missed lookup shows
+	Suggestions int `yaml:"suggestions"`
+}
+
+// TUI tunes the Terminal App
+type TUI struct {
+	// AltScreen draws the app on the terminal's alternate screen, leaving
+	// the scrollback as it was on exit
+	AltScreen bool `yaml:"alt_screen"`
+}
+
+// Update controls update checks
+type Update struct {
+	Policy update.Policy `yaml:"policy"`
+}
+
+// Default returns the settings used when nothing is configured
+func Default() *Config {
+	return &Config{
+		Format: output.FormatText,
+		Fuzzy: Fuzzy{
+			Scorer:      acronym.DefaultScorer,
+			Suggestions: 3,
+		},
+		Theme:  theme.Default,
+		TUI:    TUI{AltScreen: true},
+		Update: Update{Policy: update.PolicyAuto},
+	}
+}
+
+// Path returns the config file path: $TMDR_CONFIG, or config.yaml in
+// $XDG_CONFIG_HOME/tmdr (~/.config/tmdr by default)
+func Path() string {
+	if path := os.Getenv(PathEnv); path != "" {
+		return path
+	}
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return FileName
+		}
+		configHome = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configHome, "tmdr", FileName)
+}
+
+// Load reads the config file, if there is one, and applies TMDR_*
+// environment variables over it
+func Load() (*Config, error) {
+	c := Default()
+	path := Path()
+
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+	if err == nil {
+		if err := decode(data, c); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		// Relative dictionaries are relative to the config file
+		for i, dict := range c.Dictionaries {
+			c.Dictionaries[i] = resolvePath(filepath.Dir(path), dict)
+		}
+	}
+
+	for _, k := range Keys {
+		value, ok := os.LookupEnv(k.Env())
+		if !ok {
+			continue
+		}
+		values := []string{value}
+		if k.kind == kindList {
+			values = filepath.SplitList(value)
+			for i, dict := range values {
+				values[i] = resolvePath("", dict)
+			}
+		}
+		if err := k.set(c, values); err != nil {
+			return nil, fmt.Errorf("%s: %w", k.Env(), err)
+		}
+	}
+	return c, nil
+}
+
+// decode reads a config file over c, refusing unknown keys and values Set
+// would refuse
+func decode(data []byte, c *Config) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	for _, k := range Keys {
+		if err := k.set(&Config{}, k.get(c)); err != nil {
+			return fmt.Errorf("%s: %w", k.Name, err)
+		}
+	}
+	return nil
+}
+
+// Set writes key to the config file at path, creating the file if needed.
+// Comments and the other settings in the file are kept.
+func Set(path, name string, values ...string) error {
+	k, ok := LookupKey(name)
+	if !ok {
+		return fmt.Errorf("%w '%s'", ErrUnknownKey, name)
+	}
+	// Validate the value before touching the file
+	if err := k.set(Default(), values); err != nil {
+		return fmt.Errorf("%w for %s: %v", ErrInvalidValue, name, err)
+	}
+
+	var doc yaml.Node
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if doc.Kind == 0 || len(doc.Content) == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s: config is not a mapping of settings", path)
+	}
+	setNode(root, strings.Split(k.Name, "."), k.node(values))
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	if err := decode(buf.Bytes(), Default()); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create config folder: %w", err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	return nil
+}
+
+// setNode sets the value at a dotted path in a YAML mapping, adding the
+// mappings on the way if they are missing
+func setNode(mapping *yaml.Node, path []string, value *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			// Keep any comment on the old value
+			value.LineComment = mapping.Content[i+1].LineComment
+			mapping.Content[i+1] = value
+			return
+		}
+		child := mapping.Content[i+1]
+		if child.Kind != yaml.MappingNode {
+			*child = yaml.Node{Kind: yaml.MappingNode}
+		}
+		setNode(child, path[1:], value)
+		return
+	}
+
+	key := &yaml.Node{Kind: yaml.ScalarNode, Value: path[0]}
+	if len(path) == 1 {
+		mapping.Content = append(mapping.Content, key, value)
+		return
+	}
+	child := &yaml.Node{Kind: yaml.MappingNode}
+	mapping.Content = append(mapping.Content, key, child)
+	setNode(child, path[1:], value)
+}
+
+// resolvePath expands a leading ~ and makes a relative path relative to dir,
+// or leaves it relative to the working directory when dir is ""
+func resolvePath(dir, path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, strings.TrimPrefix(path, "~"))
+		}
+	}
+	if dir == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
@@ -0,0 +1,193 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/output"
+	"github.com/anthonylangham/tmdr/internal/theme"
+	"github.com/anthonylangham/tmdr/internal/update"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// ErrUnknownKey is matched by the error for a setting that doesn't exist
+	ErrUnknownKey = errors.New("unknown setting")
+	// ErrInvalidValue is matched by the error for a value a setting can't take
+	ErrInvalidValue = errors.New("invalid value")
+)
+
+type kind int
+
+const (
+	kindString kind = iota
+	kindInt
+	kindBool
+	kindList
+)
+
+// Key is a setting, named by its dotted path in the config file
+type Key struct {
+	Name string
+	Help string
+	// Values lists what the setting can be, when there is a fixed set
+	Values []string
+	kind   kind
+	get    func(c *Config) []string
+	set    func(c *Config, values []string) error
+}
+
+// Keys lists every setting in the order tmdr config list shows them
+var Keys = []Key{
+	{
+		Name: "dictionaries",
+		Help: "Extra dictionary files or folders, ranked below your own dictionaries",
+		kind: kindList,
+		get:  func(c *Config) []string { return c.Dictionaries },
+		set: func(c *Config, values []string) error {
+			c.Dictionaries = slices.Clone(values)
+			return nil
+		},
+	},
+	{
+		Name:   "format",
+		Help:   "Output format of lookups and searches",
+		Values: output.Formats,
+		get:    func(c *Config) []string { return []string{c.Format} },
+		set:    func(c *Config, values []string) error { return setString(&c.Format, values, output.Formats) },
+	},
+	{
+		Name:   "fuzzy.scorer",
+		Help:   "How suggestions for a misspelling are ranked",
+		Values: acronym.ScorerNames,
+		get:    func(c *Config) []string { return []string{c.Fuzzy.Scorer} },
+		set:    func(c *Config, values []string) error { return setString(&c.Fuzzy.Scorer, values, acronym.ScorerNames) },
+	},
+	{
+		Name: "fuzzy.suggestions",
+		Help: "How many suggestions a missed lookup shows",
+		kind: kindInt,
+		get:  func(c *Config) []string { return []string{strconv.Itoa(c.Fuzzy.Suggestions)} },
+		set: func(c *Config, values []string) error {
+			if len(values) != 1 {
+				return fmt.Errorf("takes one number, got %d values", len(values))
+			}
+			n, err := strconv.Atoi(values[0])
+			if err != nil || n < 1 {
+				return fmt.Errorf("'%s' is not a number of 1 or more", values[0])
+			}
+			c.Fuzzy.Suggestions = n
+			return nil
+		},
+	},
+	{
+		Name:   "theme",
+		Help:   "Colors of the Terminal App",
+		Values: theme.Names,
+		get:    func(c *Config) []string { return []string{c.Theme} },
+		set:    func(c *Config, values []string) error { return setString(&c.Theme, values, theme.Names) },
+	},
+	{
+		Name:   "tui.alt_screen",
+		Help:   "Run the Terminal App full screen, leaving the scrollback as it was",
+		Values: []string{"true", "false"},
+		kind:   kindBool,
+		get:    func(c *Config) []string { return []string{strconv.FormatBool(c.TUI.AltScreen)} },
+		set: func(c *Config, values []string) error {
+			if len(values) != 1 {
+				return fmt.Errorf("takes true or false, got %d values", len(values))
+			}
+			b, err := strconv.ParseBool(values[0])
+			if err != nil {
+				return fmt.Errorf("'%s' is not true or false", values[0])
+			}
+			c.TUI.AltScreen = b
+			return nil
+		},
+	},
+	{
+		Name:   "update.policy",
+		Help:   "Whether the Terminal App checks for and installs new releases",
+		Values: policyNames(),
+		get:    func(c *Config) []string { return []string{string(c.Update.Policy)} },
+		set: func(c *Config, values []string) error {
+			if len(values) != 1 {
+				return fmt.Errorf("takes one value, got %d", len(values))
+			}
+			policy := update.Policy(values[0])
+			if !update.IsValidPolicy(policy) {
+				return fmt.Errorf("'%s' is not one of %s", policy, strings.Join(policyNames(), ", "))
+			}
+			c.Update.Policy = policy
+			return nil
+		},
+	},
+}
+
+// LookupKey returns the setting called name
+func LookupKey(name string) (Key, bool) {
+	for _, k := range Keys {
+		if k.Name == name {
+			return k, true
+		}
+	}
+	return Key{}, false
+}
+
+// Env returns the environment variable that overrides the setting, such as
+// TMDR_FUZZY_SCORER for fuzzy.scorer
+func (k Key) Env() string {
+	return "TMDR_" + strings.ToUpper(strings.ReplaceAll(k.Name, ".", "_"))
+}
+
+// IsList reports whether the setting takes several values
+func (k Key) IsList() bool {
+	return k.kind == kindList
+}
+
+// Get returns the setting's value in c; a list has one entry per item
+func (k Key) Get(c *Config) []string {
+	return k.get(c)
+}
+
+// node returns the YAML for a value Set has validated
+func (k Key) node(values []string) *yaml.Node {
+	switch k.kind {
+	case kindList:
+		seq := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, v := range values {
+			seq.Content = append(seq.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v})
+		}
+		return seq
+	case kindInt:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: values[0]}
+	case kindBool:
+		b, _ := strconv.ParseBool(values[0])
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(b)}
+	}
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: values[0]}
+}
+
+// setString sets a single value that must be one of allowed
+func setString(dst *string, values, allowed []string) error {
+	if len(values) != 1 {
+		return fmt.Errorf("takes one value, got %d", len(values))
+	}
+	if !slices.Contains(allowed, values[0]) {
+		return fmt.Errorf("'%s' is not one of %s", values[0], strings.Join(allowed, ", "))
+	}
+	*dst = values[0]
+	return nil
+}
+
+func policyNames() []string {
+	names := make([]string, len(update.Policies))
+	for i, p := range update.Policies {
+		names[i] = string(p)
+	}
+	return names
+}
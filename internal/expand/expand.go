@@ -0,0 +1,159 @@
+package expand
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+)
+
+// Style controls how acronyms are annotated
+type Style string
+
+const (
+	// StyleInline writes the full form after the first use: ABG (Arterial Blood Gas)
+	StyleInline Style = "inline"
+	// StyleFootnote marks the first use with a Markdown footnote
+	StyleFootnote Style = "footnote"
+	// StyleGlossary leaves the text alone and appends a glossary
+	StyleGlossary Style = "glossary"
+)
+
+// Styles lists every supported style
+var Styles = []Style{StyleInline, StyleFootnote, StyleGlossary}
+
+// Options controls how text is expanded
+type Options struct {
+	Style Style
+}
+
+// Expand annotates the known acronyms in text with the sense the surrounding
+// words point to. Fenced code blocks and inline code spans are left
+// untouched, and each meaning of an acronym is annotated once.
+func Expand(text string, repo acronym.Repository, opts Options) (string, error) {
+	mentions := firstMentions(Scan(text, repo, ScanOptions{SkipCode: true}))
+
+	switch opts.Style {
+	case StyleInline, "":
+		return expandInline(text, mentions), nil
+	case StyleFootnote:
+		return expandFootnotes(text, mentions), nil
+	case StyleGlossary:
+		return appendGlossary(text, mentions), nil
+	default:
+		return "", fmt.Errorf("unknown style %q", opts.Style)
+	}
+}
+
+// firstMentions keeps the first mention of each meaning of each acronym
+func firstMentions(mentions []Mention) []Mention {
+	seen := make(map[string]bool)
+	var first []Mention
+	for _, m := range mentions {
+		key := m.Acronym.Acronym + "\x00" + m.Sense.FullForm
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		first = append(first, m)
+	}
+	return first
+}
+
+func expandInline(text string, mentions []Mention) string {
+	var b strings.Builder
+	prev := 0
+	for _, m := range mentions {
+		fullForm := m.Sense.FullForm
+		b.WriteString(text[prev:m.End])
+		if !alreadyExpanded(text, m, fullForm) {
+			fmt.Fprintf(&b, " (%s)", fullForm)
+		}
+		prev = m.End
+	}
+	b.WriteString(text[prev:])
+	return b.String()
+}
+
+func expandFootnotes(text string, mentions []Mention) string {
+	if len(mentions) == 0 {
+		return text
+	}
+
+	var b strings.Builder
+	var notes []Mention
+	prev := 0
+	for _, m := range mentions {
+		if alreadyExpanded(text, m, m.Sense.FullForm) {
+			continue
+		}
+		notes = append(notes, m)
+		b.WriteString(text[prev:m.End])
+		fmt.Fprintf(&b, "[^%d]", len(notes))
+		prev = m.End
+	}
+	b.WriteString(text[prev:])
+
+	if len(notes) == 0 {
+		return text
+	}
+	writeSectionBreak(&b)
+	for i, m := range notes {
+		fmt.Fprintf(&b, "[^%d]: %s: %s\n", i+1, m.Term, describe(m.Sense))
+	}
+	return b.String()
+}
+
+func appendGlossary(text string, mentions []Mention) string {
+	if len(mentions) == 0 {
+		return text
+	}
+
+	sorted := append([]Mention(nil), mentions...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Acronym.Acronym < sorted[j].Acronym.Acronym
+	})
+
+	var b strings.Builder
+	b.WriteString(text)
+	writeSectionBreak(&b)
+	b.WriteString("## Glossary\n\n")
+	for _, m := range sorted {
+		fmt.Fprintf(&b, "- **%s**: %s\n", m.Acronym.Acronym, describe(m.Sense))
+	}
+	return b.String()
+}
+
+// alreadyExpanded reports whether the text already spells out the acronym
+// next to this mention, as in "ABG (Arterial Blood Gas)" or
+// "Arterial Blood Gas (ABG)"
+func alreadyExpanded(text string, m Mention, fullForm string) bool {
+	after := text[m.End:]
+	if len(after) >= len(fullForm)+2 && strings.EqualFold(after[:len(fullForm)+2], " ("+fullForm) {
+		return true
+	}
+	before := text[:m.Start]
+	return len(before) >= len(fullForm)+2 && strings.EqualFold(before[len(before)-len(fullForm)-2:], fullForm+" (")
+}
+
+// describe renders a sense as "Full Form – definition"
+func describe(sense acronym.Sense) string {
+	if sense.Definition == "" {
+		return sense.FullForm
+	}
+	return sense.FullForm + " " + acronym.DefinitionSeparator + " " + sense.Definition
+}
+
+// writeSectionBreak leaves a blank line before appended notes
+func writeSectionBreak(b *strings.Builder) {
+	s := b.String()
+	switch {
+	case s == "":
+	case strings.HasSuffix(s, "\n\n"):
+	case strings.HasSuffix(s, "\n"):
+		b.WriteString("\n")
+	default:
+		b.WriteString("\n\n")
+	}
+}
@@ -0,0 +1,236 @@
+package expand
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+)
+
+// Mention is a known acronym found in a piece of text
+type Mention struct {
+	// Term is the acronym as written in the text
+	Term string
+	// Start and End are byte offsets of Term in the text
+	Start int
+	End   int
+	// Line and Column are 1-based; Column counts bytes
+	Line    int
+	Column  int
+	Acronym acronym.Acronym
+	// Sense is the meaning the surrounding paragraph points to, which is the
+	// primary sense unless the text suggests another
+	Sense acronym.Sense
+}
+
+// ScanOptions controls which parts of the text are scanned
+type ScanOptions struct {
+	// SkipCode leaves fenced code blocks and inline code spans alone
+	SkipCode bool
+}
+
+// Scan finds every known acronym in text. Only whole words that look like
+// acronyms are considered: at least two upper case letters, as in ABG, AFib
+// or HbA1c. A trailing plural "s" is allowed, so "ABGs" finds ABG.
+func Scan(text string, repo acronym.Repository, opts ScanOptions) []Mention {
+	s := &scanner{repo: repo, cache: make(map[string]*acronym.Acronym)}
+
+	var mentions []Mention
+	fence := ""
+	lineNo := 1
+	for lineStart := 0; lineStart <= len(text); lineNo++ {
+		lineEnd := strings.IndexByte(text[lineStart:], '\n')
+		if lineEnd < 0 {
+			lineEnd = len(text)
+		} else {
+			lineEnd += lineStart
+		}
+		line := text[lineStart:lineEnd]
+
+		if opts.SkipCode {
+			if marker := fenceMarker(line); marker != "" {
+				if fence == "" {
+					fence = marker
+				} else if marker[0] == fence[0] && len(marker) >= len(fence) {
+					fence = ""
+				}
+				lineStart = lineEnd + 1
+				continue
+			}
+		}
+		if fence == "" {
+			mentions = append(mentions, s.scanLine(line, lineStart, lineNo, opts.SkipCode)...)
+		}
+		lineStart = lineEnd + 1
+	}
+
+	for i := range mentions {
+		mentions[i].Sense = mentions[i].Acronym.InContext(surrounding(text, mentions[i]))
+	}
+	return mentions
+}
+
+// contextWindow caps how far either side of a mention surrounding looks, in
+// bytes, so a document without paragraph breaks isn't all one context
+const contextWindow = 300
+
+// surrounding returns the paragraph around a mention, which is the context
+// used to pick its sense
+func surrounding(text string, m Mention) string {
+	start := max(0, m.Start-contextWindow)
+	if i := strings.LastIndex(text[start:m.Start], "\n\n"); i >= 0 {
+		start += i + 2
+	}
+	end := min(len(text), m.End+contextWindow)
+	if i := strings.Index(text[m.End:end], "\n\n"); i >= 0 {
+		end = m.End + i
+	}
+	return text[start:end]
+}
+
+// scanner looks up candidate words, caching hits and misses
+type scanner struct {
+	repo  acronym.Repository
+	cache map[string]*acronym.Acronym
+}
+
+func (s *scanner) scanLine(line string, offset, lineNo int, skipCode bool) []Mention {
+	var mentions []Mention
+	for i := 0; i < len(line); {
+		// Step over inline code spans
+		if skipCode && line[i] == '`' {
+			if end := codeSpanEnd(line, i); end > 0 {
+				i = end
+				continue
+			}
+		}
+
+		r, size := utf8.DecodeRuneInString(line[i:])
+		if !isWordRune(r) {
+			i += size
+			continue
+		}
+
+		// Hyphens, slashes and underscores between word characters join a
+		// compound such as TNF-α or D/C, which is looked up as a whole
+		start := i
+		for i < len(line) {
+			r, size = utf8.DecodeRuneInString(line[i:])
+			if isWordRune(r) {
+				i += size
+				continue
+			}
+			if isJoiner(r) && i+size < len(line) {
+				if next, _ := utf8.DecodeRuneInString(line[i+size:]); isWordRune(next) {
+					i += size
+					continue
+				}
+			}
+			break
+		}
+
+		word := line[start:i]
+		if a := s.lookup(word); a != nil {
+			mentions = append(mentions, Mention{
+				Term:    word,
+				Start:   offset + start,
+				End:     offset + i,
+				Line:    lineNo,
+				Column:  start + 1,
+				Acronym: *a,
+			})
+		}
+	}
+	return mentions
+}
+
+// lookup returns the acronym a word refers to, or nil
+func (s *scanner) lookup(word string) *acronym.Acronym {
+	if !looksLikeAcronym(word) {
+		return nil
+	}
+	if a, ok := s.cache[word]; ok {
+		return a
+	}
+
+	a, err := s.repo.Find(strings.ToUpper(word))
+	if err != nil {
+		a = nil
+		// Allow plurals such as ABGs
+		if singular := strings.TrimSuffix(word, "s"); singular != word && looksLikeAcronym(singular) {
+			a, err = s.repo.Find(strings.ToUpper(singular))
+			if err != nil {
+				a = nil
+			}
+		}
+	}
+	s.cache[word] = a
+	return a
+}
+
+// looksLikeAcronym reports whether word has at least two upper case letters
+func looksLikeAcronym(word string) bool {
+	upper := 0
+	for _, r := range word {
+		if unicode.IsUpper(r) {
+			upper++
+		}
+	}
+	return upper >= 2
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isJoiner(r rune) bool {
+	return r == '-' || r == '/' || r == '_'
+}
+
+// fenceMarker returns the fence run if line opens or closes a fenced code
+// block, or ""
+func fenceMarker(line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 {
+		return ""
+	}
+	for _, marker := range []byte{'`', '~'} {
+		n := 0
+		for n < len(trimmed) && trimmed[n] == marker {
+			n++
+		}
+		if n >= 3 {
+			return trimmed[:n]
+		}
+	}
+	return ""
+}
+
+// codeSpanEnd returns the offset just past the inline code span opening at
+// start, or 0 if the backticks are never closed
+func codeSpanEnd(line string, start int) int {
+	n := 0
+	for start+n < len(line) && line[start+n] == '`' {
+		n++
+	}
+	ticks := line[start : start+n]
+
+	for i := start + n; i < len(line); {
+		idx := strings.Index(line[i:], ticks)
+		if idx < 0 {
+			return 0
+		}
+		end := i + idx + n
+		// The closing run must be exactly as long as the opening one
+		if end < len(line) && line[end] == '`' {
+			for end < len(line) && line[end] == '`' {
+				end++
+			}
+			i = end
+			continue
+		}
+		return end
+	}
+	return 0
+}
@@ -0,0 +1,161 @@
+package glossary
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/expand"
+)
+
+// DefaultExtensions are the source and documentation files scanned when no
+// extensions are given
+var DefaultExtensions = []string{
+	".md", ".markdown", ".mdx", ".txt", ".rst", ".adoc", ".html", ".htm",
+	".go", ".py", ".js", ".jsx", ".ts", ".tsx", ".java", ".kt", ".scala",
+	".rb", ".rs", ".c", ".h", ".cc", ".cpp", ".hpp", ".cs", ".swift", ".php",
+	".sql", ".sh", ".yaml", ".yml", ".json", ".toml", ".proto", ".graphql",
+}
+
+// skipDirs are never descended into
+var skipDirs = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+	"dist":         true,
+	"build":        true,
+	"target":       true,
+	"__pycache__":  true,
+}
+
+// maxFileSize skips generated bundles and data dumps
+const maxFileSize = 2 << 20
+
+// Location is where an acronym was found
+type Location struct {
+	Path   string `json:"path"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+// Entry is a glossary term with everywhere it occurs
+type Entry struct {
+	Acronym   acronym.Acronym
+	Count     int
+	Locations []Location
+}
+
+// Options controls which files are scanned
+type Options struct {
+	// Extensions limits the scan to these file extensions; DefaultExtensions
+	// is used when empty
+	Extensions []string
+	// Exclude lists files to leave out, such as a previously generated glossary
+	Exclude []string
+}
+
+// Build walks root and returns every known acronym found, sorted by acronym.
+// Hidden directories and common dependency and build folders are skipped.
+func Build(root string, repo acronym.Repository, opts Options) ([]Entry, error) {
+	entries := make(map[string]*Entry)
+	err := Walk(root, opts, func(path string, data []byte) error {
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			rel = path
+		}
+		rel = filepath.ToSlash(rel)
+
+		for _, m := range expand.Scan(string(data), repo, expand.ScanOptions{}) {
+			key := m.Acronym.Acronym
+			entry, ok := entries[key]
+			if !ok {
+				entry = &Entry{Acronym: m.Acronym}
+				entries[key] = entry
+			}
+			entry.Count++
+			entry.Locations = append(entry.Locations, Location{Path: rel, Line: m.Line, Column: m.Column})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Entry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, *entry)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Acronym.Acronym < result[j].Acronym.Acronym
+	})
+	return result, nil
+}
+
+// Walk calls fn with the path and contents of every text file under root
+// that Build would scan, skipping hidden directories, common dependency and
+// build folders, other extensions, large files and binaries
+func Walk(root string, opts Options, fn func(path string, data []byte) error) error {
+	extensions := opts.Extensions
+	if len(extensions) == 0 {
+		extensions = DefaultExtensions
+	}
+	allowed := make(map[string]bool)
+	for _, ext := range extensions {
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		allowed[strings.ToLower(ext)] = true
+	}
+
+	excluded := make(map[string]bool)
+	for _, path := range opts.Exclude {
+		if abs, err := filepath.Abs(path); err == nil {
+			excluded[abs] = true
+		}
+	}
+
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != root && (strings.HasPrefix(name, ".") || skipDirs[name]) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !allowed[strings.ToLower(filepath.Ext(path))] {
+			return nil
+		}
+		if abs, err := filepath.Abs(path); err == nil && excluded[abs] {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil || info.Size() > maxFileSize {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if isBinary(data) {
+			return nil
+		}
+
+		return fn(path, data)
+	})
+}
+
+// isBinary guesses whether data is binary by looking for NUL bytes near the start
+func isBinary(data []byte) bool {
+	if len(data) > 8000 {
+		data = data[:8000]
+	}
+	return bytes.IndexByte(data, 0) >= 0
+}
@@ -0,0 +1,179 @@
+package glossary
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+)
+
+// Output formats for a glossary
+const (
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+	FormatJSON     = "json"
+)
+
+// maxListedLocations caps the locations shown per term in Markdown and HTML
+const maxListedLocations = 10
+
+// Write renders entries in format
+func Write(w io.Writer, format string, entries []Entry) error {
+	switch format {
+	case FormatMarkdown:
+		return writeMarkdown(w, entries)
+	case FormatHTML:
+		return writeHTML(w, entries)
+	case FormatJSON:
+		return writeJSON(w, entries)
+	default:
+		return fmt.Errorf("unsupported glossary format %q (want markdown, html or json)", format)
+	}
+}
+
+// FormatForPath picks a format from an output file name, defaulting to Markdown
+func FormatForPath(path string) string {
+	lower := strings.ToLower(path)
+	switch {
+	case strings.HasSuffix(lower, ".html"), strings.HasSuffix(lower, ".htm"):
+		return FormatHTML
+	case strings.HasSuffix(lower, ".json"):
+		return FormatJSON
+	default:
+		return FormatMarkdown
+	}
+}
+
+func writeMarkdown(w io.Writer, entries []Entry) error {
+	var b strings.Builder
+	b.WriteString("# Glossary\n\n")
+	b.WriteString("Medical acronyms used in this project. Generated by `tmdr glossary`.\n")
+
+	for _, e := range entries {
+		fmt.Fprintf(&b, "\n## %s\n\n", e.Acronym.Acronym)
+		for i, s := range e.Acronym.Senses {
+			prefix := "-"
+			if len(e.Acronym.Senses) > 1 {
+				prefix = fmt.Sprintf("%d.", i+1)
+			}
+			fmt.Fprintf(&b, "%s **%s**", prefix, s.FullForm)
+			if s.Definition != "" {
+				fmt.Fprintf(&b, ": %s", s.Definition)
+			}
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "\nUsed %d %s in %s\n", e.Count, plural(e.Count, "time", "times"), strings.Join(locationList(e), ", "))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var htmlTemplate = template.Must(template.New("glossary").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Glossary</title>
+<style>
+body { font-family: sans-serif; max-width: 60rem; margin: 2rem auto; padding: 0 1rem; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border-bottom: 1px solid #ddd; padding: .5rem; text-align: left; vertical-align: top; }
+code { font-size: .85em; }
+</style>
+</head>
+<body>
+<h1>Glossary</h1>
+<p>Medical acronyms used in this project. Generated by <code>tmdr glossary</code>.</p>
+<table>
+<thead><tr><th>Acronym</th><th>Meaning</th><th>Uses</th><th>Locations</th></tr></thead>
+<tbody>
+{{- range .}}
+<tr id="{{.Acronym}}">
+<td><strong>{{.Acronym}}</strong></td>
+<td>{{range $i, $s := .Senses}}{{if $i}}<br>{{end}}<strong>{{$s.FullForm}}</strong>{{if $s.Definition}}: {{$s.Definition}}{{end}}{{end}}</td>
+<td>{{.Count}}</td>
+<td>{{range $i, $l := .Locations}}{{if $i}}, {{end}}<code>{{$l}}</code>{{end}}</td>
+</tr>
+{{- end}}
+</tbody>
+</table>
+</body>
+</html>
+`))
+
+func writeHTML(w io.Writer, entries []Entry) error {
+	type row struct {
+		Acronym   string
+		Senses    []acronym.Sense
+		Count     int
+		Locations []string
+	}
+	rows := make([]row, len(entries))
+	for i, e := range entries {
+		rows[i] = row{
+			Acronym:   e.Acronym.Acronym,
+			Senses:    e.Acronym.Senses,
+			Count:     e.Count,
+			Locations: locationList(e),
+		}
+	}
+	return htmlTemplate.Execute(w, rows)
+}
+
+func writeJSON(w io.Writer, entries []Entry) error {
+	type sense struct {
+		FullForm   string `json:"full_form"`
+		Definition string `json:"definition,omitempty"`
+	}
+	type term struct {
+		Acronym   string     `json:"acronym"`
+		Senses    []sense    `json:"senses"`
+		Count     int        `json:"count"`
+		Locations []Location `json:"locations"`
+	}
+
+	terms := make([]term, len(entries))
+	for i, e := range entries {
+		terms[i] = term{
+			Acronym:   e.Acronym.Acronym,
+			Count:     e.Count,
+			Locations: e.Locations,
+		}
+		for _, s := range e.Acronym.Senses {
+			terms[i].Senses = append(terms[i].Senses, sense{FullForm: s.FullForm, Definition: s.Definition})
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(terms)
+}
+
+// locationList formats the first few locations of an entry as path:line,
+// listing a line once however often the acronym appears on it
+func locationList(e Entry) []string {
+	var list []string
+	seen := make(map[string]bool)
+	for _, l := range e.Locations {
+		loc := fmt.Sprintf("%s:%d", l.Path, l.Line)
+		if !seen[loc] {
+			seen[loc] = true
+			list = append(list, loc)
+		}
+	}
+	if len(list) > maxListedLocations {
+		more := fmt.Sprintf("and %d more", len(list)-maxListedLocations)
+		list = append(list[:maxListedLocations], more)
+	}
+	return list
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
@@ -0,0 +1,73 @@
+// Package jsonrpc holds the JSON-RPC 2.0 messages the language server and
+// MCP server exchange with their clients. Each server frames them its own way.
+package jsonrpc
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Version is the protocol version every message carries
+const Version = "2.0"
+
+// Message is a JSON-RPC 2.0 request, notification or response
+type Message struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  json.RawMessage  `json:"result,omitempty"`
+	Error   *Error           `json:"error,omitempty"`
+}
+
+// Error is the error member of a failed response
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// JSON-RPC error codes
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+)
+
+// Response answers the request with id with result
+func Response(id *json.RawMessage, result any) (*Message, error) {
+	body, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result: %w", err)
+	}
+	return &Message{ID: id, Result: body}, nil
+}
+
+// ErrorResponse answers the request with id with an error. A nil id, for a
+// request that couldn't be read, is sent as null.
+func ErrorResponse(id *json.RawMessage, code int, message string) *Message {
+	if id == nil {
+		null := json.RawMessage("null")
+		id = &null
+	}
+	return &Message{ID: id, Error: &Error{Code: code, Message: message}}
+}
+
+// Notification is a message to the client that expects no answer
+func Notification(method string, params any) (*Message, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode params: %w", err)
+	}
+	return &Message{Method: method, Params: body}, nil
+}
+
+// Marshal encodes msg, stamping it with the protocol version
+func Marshal(msg *Message) ([]byte, error) {
+	msg.JSONRPC = Version
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode message: %w", err)
+	}
+	return body, nil
+}
@@ -0,0 +1,367 @@
+package lint
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"gopkg.in/yaml.v3"
+)
+
+// Severity ranks how serious an issue is
+type Severity int
+
+const (
+	Warning Severity = iota
+	Error
+)
+
+func (s Severity) String() string {
+	if s == Error {
+		return "error"
+	}
+	return "warning"
+}
+
+// DefaultMaxDefinitionLength is the definition length above which a warning is reported
+const DefaultMaxDefinitionLength = 200
+
+// Issue is a single problem found in a dictionary file
+type Issue struct {
+	Line     int
+	Column   int
+	Severity Severity
+	Message  string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%d:%d: %s: %s", i.Line, i.Column, i.Severity, i.Message)
+}
+
+// Options tunes the checks
+type Options struct {
+	MaxDefinitionLength int
+}
+
+// File lints a dictionary file, picking the format from its extension
+func File(path string, opts Options) ([]Issue, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open dictionary file: %w", err)
+	}
+	defer file.Close()
+
+	var issues []Issue
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		issues = CSV(file, opts)
+	case ".json", ".yaml", ".yml":
+		// JSON is valid YAML, which lets both formats report line numbers
+		issues = Structured(file, opts)
+	default:
+		return nil, fmt.Errorf("unsupported dictionary format: %s", path)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Line != issues[j].Line {
+			return issues[i].Line < issues[j].Line
+		}
+		return issues[i].Column < issues[j].Column
+	})
+	return issues, nil
+}
+
+// field is a dictionary value with the position it was read from
+type field struct {
+	value  string
+	line   int
+	column int
+}
+
+// record is one sense as read from any dictionary format
+type record struct {
+	line       int
+	acronym    field
+	fullForm   field
+	definition field
+}
+
+// checker runs the checks shared by every format
+type checker struct {
+	opts     Options
+	issues   []Issue
+	spelling map[string]field
+	senses   map[string]int
+}
+
+func newChecker(opts Options) *checker {
+	if opts.MaxDefinitionLength <= 0 {
+		opts.MaxDefinitionLength = DefaultMaxDefinitionLength
+	}
+	return &checker{
+		opts:     opts,
+		spelling: make(map[string]field),
+		senses:   make(map[string]int),
+	}
+}
+
+func (c *checker) report(line, column int, severity Severity, format string, args ...any) {
+	c.issues = append(c.issues, Issue{
+		Line:     line,
+		Column:   column,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// checkRecord validates a parsed record and tracks it for cross-row checks
+func (c *checker) checkRecord(r record) {
+	if strings.TrimSpace(r.acronym.value) == "" {
+		c.report(r.acronym.line, r.acronym.column, Error, "missing acronym")
+		return
+	}
+	if strings.TrimSpace(r.fullForm.value) == "" {
+		c.report(r.fullForm.line, r.fullForm.column, Error, "missing full form for %s", r.acronym.value)
+		return
+	}
+
+	if len(r.definition.value) > 0 && utf8.RuneCountInString(r.definition.value) > c.opts.MaxDefinitionLength {
+		c.report(r.definition.line, r.definition.column, Warning,
+			"definition is %d characters, longer than %d",
+			utf8.RuneCountInString(r.definition.value), c.opts.MaxDefinitionLength)
+	}
+
+	acronymText := strings.TrimSpace(r.acronym.value)
+	key := strings.ToUpper(acronymText)
+
+	// The same spelling on several rows is a multi-sense acronym, but a
+	// differently cased spelling folds onto the same key
+	if first, seen := c.spelling[key]; seen {
+		if first.value != acronymText {
+			c.report(r.acronym.line, r.acronym.column, Warning,
+				"%s conflicts with %s on line %d; both are looked up as %s",
+				acronymText, first.value, first.line, key)
+		}
+	} else {
+		c.spelling[key] = field{value: acronymText, line: r.acronym.line, column: r.acronym.column}
+	}
+
+	senseKey := key + "\x00" + strings.ToLower(strings.TrimSpace(r.fullForm.value))
+	if line, seen := c.senses[senseKey]; seen {
+		c.report(r.line, r.acronym.column, Error,
+			"duplicate entry %s (%s); line %d already defines it and this row is ignored",
+			acronymText, strings.TrimSpace(r.fullForm.value), line)
+	} else {
+		c.senses[senseKey] = r.line
+	}
+}
+
+// checkText reports whitespace and lookalike characters in a raw value
+func (c *checker) checkText(name string, f field, lookalikeSeverity Severity) {
+	if f.value != strings.TrimSpace(f.value) {
+		c.report(f.line, f.column, Warning, "%s has leading or trailing whitespace", name)
+	}
+
+	column := f.column
+	for _, r := range f.value {
+		if suggestion, ok := lookalikes[r]; ok {
+			c.report(f.line, column, lookalikeSeverity,
+				"%s contains %U %q; did you mean %q?", name, r, r, suggestion)
+		}
+		column++
+	}
+}
+
+// checkSpecialty warns about a specialty outside the taxonomy, which still
+// works but won't be grouped with the built-in categories
+func (c *checker) checkSpecialty(f field) {
+	if strings.TrimSpace(f.value) == "" {
+		return
+	}
+	if _, ok := acronym.NormalizeSpecialty(f.value); !ok {
+		c.report(f.line, f.column, Warning, "specialty %q is not in the taxonomy (%s)",
+			f.value, strings.Join(acronym.SpecialtyNames(), ", "))
+	}
+}
+
+// CSV lints a CSV dictionary
+func CSV(in io.Reader, opts Options) []Issue {
+	c := newChecker(opts)
+
+	reader := csv.NewReader(in)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		c.report(1, 1, Error, "failed to read CSV header: %v", err)
+		return c.issues
+	}
+	if len(header) < 2 || strings.TrimPrefix(header[0], "\ufeff") != "acronym" || header[1] != "definition" {
+		c.report(1, 1, Error, "header should be %q", "acronym,definition")
+	}
+	// The specialty, context and alternative columns are optional, in that
+	// order
+	fields := 2
+	for _, name := range []string{"specialty", "context", "alternative"} {
+		if len(header) <= fields || header[fields] != name {
+			break
+		}
+		fields++
+	}
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				c.report(parseErr.Line, parseErr.Column, Error, "malformed row: %v", parseErr.Err)
+				continue
+			}
+			c.report(0, 0, Error, "failed to read CSV record: %v", err)
+			break
+		}
+
+		line, column := reader.FieldPos(0)
+		if len(row) < 2 {
+			c.report(line, column, Error, "malformed row: expected 2 fields, got %d", len(row))
+			continue
+		}
+		if len(row) > fields {
+			extraLine, extraColumn := reader.FieldPos(fields)
+			c.report(extraLine, extraColumn, Warning, "row has %d fields; fields after the %s are ignored", len(row), header[fields-1])
+		}
+		if fields > 2 && len(row) > 2 {
+			c.checkSpecialty(positioned(reader, row, 2))
+		}
+
+		acronymField := positioned(reader, row, 0)
+		definitionField := positioned(reader, row, 1)
+		c.checkText("acronym", acronymField, Error)
+		c.checkText("definition", definitionField, Warning)
+
+		if !strings.Contains(row[1], acronym.DefinitionSeparator) {
+			message := "definition has no %q separator between full form and description"
+			if strings.Contains(row[1], "—") || strings.Contains(row[1], " - ") {
+				message += "; use an en dash, not an em dash or hyphen"
+			}
+			c.report(definitionField.line, definitionField.column, Error, message, acronym.DefinitionSeparator)
+		}
+
+		fullForm, definition := acronym.SplitDefinition(row[1])
+		c.checkRecord(record{
+			line:       line,
+			acronym:    acronymField,
+			fullForm:   field{value: fullForm, line: definitionField.line, column: definitionField.column},
+			definition: field{value: definition, line: definitionField.line, column: definitionField.column},
+		})
+	}
+
+	return c.issues
+}
+
+// Structured lints a JSON or YAML dictionary
+func Structured(in io.Reader, opts Options) []Issue {
+	c := newChecker(opts)
+
+	var doc yaml.Node
+	if err := yaml.NewDecoder(in).Decode(&doc); err != nil {
+		if err == io.EOF {
+			return c.issues
+		}
+		c.report(0, 0, Error, "failed to parse dictionary: %v", err)
+		return c.issues
+	}
+
+	root := &doc
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	if root.Kind != yaml.SequenceNode {
+		c.report(root.Line, root.Column, Error, "dictionary should be a list of entries")
+		return c.issues
+	}
+
+	for _, item := range root.Content {
+		if item.Kind != yaml.MappingNode {
+			c.report(item.Line, item.Column, Error, "malformed entry: expected an object with acronym and full_form")
+			continue
+		}
+
+		r := record{line: item.Line}
+		r.acronym = field{line: item.Line, column: item.Column}
+		r.fullForm = field{line: item.Line, column: item.Column}
+		var doNotUse, alternative *yaml.Node
+		for i := 0; i+1 < len(item.Content); i += 2 {
+			key, value := item.Content[i], item.Content[i+1]
+			f := field{value: value.Value, line: value.Line, column: value.Column}
+			switch key.Value {
+			case "acronym":
+				r.acronym = f
+				c.checkText("acronym", f, Error)
+			case "full_form":
+				r.fullForm = f
+				c.checkText("full_form", f, Warning)
+			case "definition":
+				r.definition = f
+				c.checkText("definition", f, Warning)
+			case "specialty":
+				c.checkSpecialty(f)
+			case "do_not_use":
+				doNotUse = value
+			case "alternative":
+				alternative = value
+			case "synonyms", "references", "context":
+			default:
+				c.report(key.Line, key.Column, Warning, "unknown field %q", key.Value)
+			}
+		}
+		c.checkSafety(doNotUse, alternative)
+		c.checkRecord(r)
+	}
+
+	return c.issues
+}
+
+// checkSafety checks that a do-not-use entry says what to write instead, and
+// that an alternative isn't given for an entry that is safe to use
+func (c *checker) checkSafety(doNotUse, alternative *yaml.Node) {
+	flagged := false
+	if doNotUse != nil {
+		if err := doNotUse.Decode(&flagged); err != nil {
+			c.report(doNotUse.Line, doNotUse.Column, Error, "do_not_use should be true or false, not %q", doNotUse.Value)
+		}
+	}
+	if flagged && (alternative == nil || strings.TrimSpace(alternative.Value) == "") {
+		c.report(doNotUse.Line, doNotUse.Column, Warning, "do-not-use entry has no alternative to suggest")
+	}
+	if !flagged && alternative != nil {
+		c.report(alternative.Line, alternative.Column, Warning, "alternative is only shown when do_not_use is true")
+	}
+}
+
+// positioned returns a CSV field with its line and column
+func positioned(reader *csv.Reader, row []string, idx int) field {
+	line, column := reader.FieldPos(idx)
+	return field{value: row[idx], line: line, column: column}
+}
+
+// Errors counts the issues with Error severity
+func Errors(issues []Issue) int {
+	count := 0
+	for _, issue := range issues {
+		if issue.Severity == Error {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,117 @@
+package lint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// issueList lists issues as line:column:severity, which is what the tests
+// compare
+func issueList(issues []Issue) string {
+	var found []string
+	for _, i := range issues {
+		found = append(found, fmt.Sprintf("%d:%d:%s", i.Line, i.Column, i.Severity))
+	}
+	return strings.Join(found, " ")
+}
+
+func TestCSV(t *testing.T) {
+	tests := []struct {
+		name, data, want, message string
+	}{
+		{"clean", "acronym,definition\nABG,Arterial Blood Gas – A blood test\n", "", ""},
+		{"bad header", "name,meaning\nABG,Arterial Blood Gas – A blood test\n", "1:1:error", "header"},
+		{"missing separator", "acronym,definition\nABG,Arterial Blood Gas - A blood test\n", "2:5:error", "use an en dash"},
+		{"missing acronym", "acronym,definition\n,Arterial Blood Gas – A blood test\n", "2:1:error", "missing acronym"},
+		{"duplicate", "acronym,definition\nABG,Arterial Blood Gas – A\nABG,arterial blood gas – B\n", "3:1:error", "duplicate entry"},
+		{"case conflict", "acronym,definition\nAFib,Atrial Fibrillation – A\nAFIB,Atrial Fibrillation Two – B\n", "3:1:warning", "both are looked up as AFIB"},
+		{"whitespace", "acronym,definition\nABG ,Arterial Blood Gas – A blood test\n", "2:1:warning", "whitespace"},
+		{"lookalike", "acronym,definition\nАBG,Arterial Blood Gas – A blood test\n", "2:1:error", "U+0410"},
+		{"unknown specialty", "acronym,definition,specialty\nABG,Arterial Blood Gas – A,astrology\n", "2:30:warning", "not in the taxonomy"},
+		{"extra field", "acronym,definition\nABG,Arterial Blood Gas – A,extra\n", "2:30:warning", "ignored"},
+		{"malformed", "acronym,definition\nABG\n", "2:1:error", "expected 2 fields"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := CSV(strings.NewReader(tt.data), Options{})
+			if got := issueList(issues); got != tt.want {
+				t.Fatalf("issues %q (%v), want %q", got, issues, tt.want)
+			}
+			if tt.message != "" && !strings.Contains(issues[0].Message, tt.message) {
+				t.Errorf("message %q does not mention %q", issues[0].Message, tt.message)
+			}
+		})
+	}
+}
+
+func TestDefinitionLength(t *testing.T) {
+	data := "acronym,definition\nABG,Arterial Blood Gas – " + strings.Repeat("a", 50) + "\n"
+	if issues := CSV(strings.NewReader(data), Options{}); len(issues) != 0 {
+		t.Errorf("default limit reported %v", issues)
+	}
+	if got := issueList(CSV(strings.NewReader(data), Options{MaxDefinitionLength: 40})); got != "2:5:warning" {
+		t.Errorf("issues %q, want a warning for the long definition", got)
+	}
+}
+
+func TestStructured(t *testing.T) {
+	tests := []struct {
+		name, data, want, message string
+	}{
+		{"clean", "- acronym: ABG\n  full_form: Arterial Blood Gas\n", "", ""},
+		{"json", `[{"acronym": "ABG", "full_form": "Arterial Blood Gas"}]`, "", ""},
+		{"not a list", "acronym: ABG\n", "1:1:error", "list of entries"},
+		{"missing full form", "- acronym: ABG\n", "1:3:error", "missing full form"},
+		{"unknown field", "- acronym: ABG\n  full_form: Arterial Blood Gas\n  meaning: x\n", "3:3:warning", `unknown field "meaning"`},
+		{"no alternative", "- acronym: QD\n  full_form: Once Daily\n  do_not_use: true\n", "3:15:warning", "no alternative"},
+		{"alternative unused", "- acronym: QD\n  full_form: Once Daily\n  alternative: daily\n", "3:16:warning", "only shown"},
+		{"invalid do_not_use", "- acronym: QD\n  full_form: Once Daily\n  do_not_use: maybe\n", "3:15:error", "true or false"},
+		{"duplicate", "- acronym: ABG\n  full_form: Arterial Blood Gas\n- acronym: ABG\n  full_form: Arterial blood gas\n", "3:12:error", "duplicate entry"},
+		{"invalid YAML", "- acronym: [ABG\n", "0:0:error", "failed to parse"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := Structured(strings.NewReader(tt.data), Options{})
+			if got := issueList(issues); got != tt.want {
+				t.Fatalf("issues %q (%v), want %q", got, issues, tt.want)
+			}
+			if tt.message != "" && !strings.Contains(issues[0].Message, tt.message) {
+				t.Errorf("message %q does not mention %q", issues[0].Message, tt.message)
+			}
+		})
+	}
+}
+
+func TestFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	// Issues come back in file order, whichever check found them
+	path := write("dict.csv", "acronym,definition\nABG ,Arterial Blood Gas - A\n,B – C\n")
+	issues, err := File(path, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := issueList(issues); got != "2:1:warning 2:6:error 3:1:error" {
+		t.Errorf("issues %q, want them sorted by position", got)
+	}
+	if Errors(issues) != 2 {
+		t.Errorf("Errors = %d, want 2", Errors(issues))
+	}
+
+	if _, err := File(write("dict.txt", ""), Options{}); err == nil {
+		t.Error("File accepted an unsupported format")
+	}
+	if _, err := File(filepath.Join(dir, "missing.csv"), Options{}); err == nil {
+		t.Error("File accepted a missing file")
+	}
+}
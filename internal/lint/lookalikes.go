@@ -0,0 +1,36 @@
+package lint
+
+// lookalikes maps characters that are easily mistaken for ASCII to the
+// character the author most likely meant
+var lookalikes = map[rune]string{
+	// Cyrillic
+	'А': "A", 'В': "B", 'Е': "E", 'К': "K", 'М': "M", 'Н': "H", 'О': "O",
+	'Р': "P", 'С': "C", 'Т': "T", 'Х': "X", 'І': "I", 'Ѕ': "S",
+	'а': "a", 'е': "e", 'о': "o", 'р': "p", 'с': "c", 'у': "y", 'х': "x",
+	'і': "i", 'ѕ': "s",
+
+	// Greek
+	'Α': "A", 'Β': "B", 'Ε': "E", 'Ζ': "Z", 'Η': "H", 'Ι': "I", 'Κ': "K",
+	'Μ': "M", 'Ν': "N", 'Ο': "O", 'Ρ': "P", 'Τ': "T", 'Υ': "Y", 'Χ': "X",
+	'ο': "o",
+
+	// Dashes other than the en dash separator
+	'‐': "-", '‑': "-", '‒': "-", '−': "-", '—': "–",
+
+	// Invisible and non-breaking spaces
+	'\u00a0': " ", '\u2007': " ", '\u202f': " ",
+	'\u200b': "", '\u200c': "", '\u200d': "", '\ufeff': "",
+}
+
+func init() {
+	// Full-width Latin letters and digits, common when pasting from PDFs
+	for r := 'Ａ'; r <= 'Ｚ'; r++ {
+		lookalikes[r] = string('A' + (r - 'Ａ'))
+	}
+	for r := 'ａ'; r <= 'ｚ'; r++ {
+		lookalikes[r] = string('a' + (r - 'ａ'))
+	}
+	for r := '０'; r <= '９'; r++ {
+		lookalikes[r] = string('0' + (r - '０'))
+	}
+}
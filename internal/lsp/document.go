@@ -0,0 +1,227 @@
+package lsp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/check"
+	"github.com/anthonylangham/tmdr/internal/expand"
+)
+
+// document is an open text document with the acronyms and do-not-use
+// findings in it, worked out each time the text changes
+type document struct {
+	uri        string
+	languageID string
+	lines      []string
+	mentions   []expand.Mention
+	findings   []check.Finding
+}
+
+func newDocument(uri, languageID, text string, repo acronym.Repository, checker *check.Checker) *document {
+	return &document{
+		uri:        uri,
+		languageID: languageID,
+		lines:      lines(text),
+		mentions:   expand.Scan(text, repo, expand.ScanOptions{}),
+		findings:   checker.Text(text),
+	}
+}
+
+// line returns a zero-based line of the document, or "" past the end
+func (d *document) line(n int) string {
+	if n < 0 || n >= len(d.lines) {
+		return ""
+	}
+	return d.lines[n]
+}
+
+// mentionAt returns the acronym under a position, including the position
+// just after it where the cursor sits once the word is typed
+func (d *document) mentionAt(pos Position) (expand.Mention, bool) {
+	offset := byteOffset(d.line(pos.Line), pos.Character)
+	for _, m := range d.mentions {
+		start := m.Column - 1
+		if m.Line == pos.Line+1 && offset >= start && offset <= start+len(m.Term) {
+			return m, true
+		}
+	}
+	return expand.Mention{}, false
+}
+
+// mentionRange returns the range of an acronym in the document
+func (d *document) mentionRange(m expand.Mention) Range {
+	start := m.Column - 1
+	return span(m.Line-1, d.line(m.Line-1), start, start+len(m.Term))
+}
+
+// findingRange returns the range of a do-not-use finding in the document
+func (d *document) findingRange(f check.Finding) Range {
+	start := f.Column - 1
+	return span(f.Line-1, d.line(f.Line-1), start, start+len(f.Term))
+}
+
+// diagnostics reports each do-not-use finding as a warning
+func (d *document) diagnostics() []Diagnostic {
+	diagnostics := make([]Diagnostic, 0, len(d.findings))
+	for _, f := range d.findings {
+		diagnostics = append(diagnostics, Diagnostic{
+			Range:    d.findingRange(f),
+			Severity: SeverityWarning,
+			Code:     "do-not-use",
+			Source:   "tmdr",
+			Message:  f.Message,
+		})
+	}
+	return diagnostics
+}
+
+// proseLanguages are documents that are all prose, where any acronym can be
+// expanded
+var proseLanguages = map[string]bool{
+	"":                 true,
+	"plaintext":        true,
+	"markdown":         true,
+	"asciidoc":         true,
+	"restructuredtext": true,
+	"latex":            true,
+}
+
+// commentMarkers lists the markers that start a comment in each language.
+// Languages not listed fall back to defaultCommentMarkers.
+var commentMarkers = map[string][]string{
+	"python":      {"#"},
+	"ruby":        {"#"},
+	"r":           {"#"},
+	"perl":        {"#"},
+	"shellscript": {"#"},
+	"yaml":        {"#"},
+	"toml":        {"#"},
+	"dockerfile":  {"#"},
+	"makefile":    {"#"},
+	"elixir":      {"#"},
+	"sql":         {"--", "/*"},
+	"lua":         {"--"},
+	"haskell":     {"--"},
+	"html":        {"<!--"},
+	"xml":         {"<!--"},
+	"clojure":     {";"},
+	"lisp":        {";"},
+}
+
+var defaultCommentMarkers = []string{"//", "/*", "#", "--"}
+
+// inComment reports whether an acronym is in a comment, or anywhere in a
+// prose document. Comments are found line by line: a comment marker earlier
+// on the line, or a line starting with * inside a block comment.
+func (d *document) inComment(m expand.Mention) bool {
+	if proseLanguages[d.languageID] {
+		return true
+	}
+
+	line := d.line(m.Line - 1)
+	before := line[:m.Column-1]
+	if strings.HasPrefix(strings.TrimSpace(before), "*") {
+		return true
+	}
+	markers, ok := commentMarkers[d.languageID]
+	if !ok {
+		markers = defaultCommentMarkers
+	}
+	for _, marker := range markers {
+		if strings.Contains(before, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// expandAction spells out an acronym after it, as in "ABG (Arterial Blood
+// Gas)", unless the text already does. Do-not-use abbreviations are left to
+// the fixes that replace them.
+func (d *document) expandAction(m expand.Mention) (CodeAction, bool) {
+	if m.Sense.DoNotUse {
+		return CodeAction{}, false
+	}
+	fullForm := m.Sense.FullForm
+	line := d.line(m.Line - 1)
+	end := m.Column - 1 + len(m.Term)
+	if strings.HasPrefix(strings.ToLower(line[end:]), strings.ToLower(" ("+fullForm)) {
+		return CodeAction{}, false
+	}
+
+	at := Position{Line: m.Line - 1, Character: utf16Column(line, end)}
+	return CodeAction{
+		Title: fmt.Sprintf("Expand %s to %s", m.Term, fullForm),
+		Kind:  KindRefactorRewrite,
+		Edit: WorkspaceEdit{Changes: map[string][]TextEdit{
+			d.uri: {{Range: Range{Start: at, End: at}, NewText: " (" + fullForm + ")"}},
+		}},
+	}, true
+}
+
+// fixActions offer to replace a do-not-use finding with each alternative
+func (d *document) fixActions(f check.Finding) []CodeAction {
+	r := d.findingRange(f)
+	diagnostic := Diagnostic{Range: r, Severity: SeverityWarning, Code: "do-not-use", Source: "tmdr", Message: f.Message}
+
+	var actions []CodeAction
+	for i, alternative := range f.Alternatives {
+		actions = append(actions, CodeAction{
+			Title:       fmt.Sprintf("Replace %s with %q", f.Term, alternative),
+			Kind:        KindQuickFix,
+			Diagnostics: []Diagnostic{diagnostic},
+			IsPreferred: i == 0 && len(f.Alternatives) == 1,
+			Edit: WorkspaceEdit{Changes: map[string][]TextEdit{
+				d.uri: {{Range: r, NewText: alternative}},
+			}},
+		})
+	}
+	return actions
+}
+
+// hoverText describes an acronym in Markdown, leading with the meaning the
+// surrounding text points to
+func hoverText(m expand.Mention) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%s** — %s", m.Acronym.Acronym, m.Sense.FullForm)
+	writeSense(&b, m.Sense)
+
+	var others []acronym.Sense
+	for _, s := range m.Acronym.Senses {
+		if s.FullForm != m.Sense.FullForm {
+			others = append(others, s)
+		}
+	}
+	if len(others) > 0 {
+		b.WriteString("\n\n---\n\nAlso:")
+		for _, s := range others {
+			fmt.Fprintf(&b, "\n\n**%s**", s.FullForm)
+			writeSense(&b, s)
+		}
+	}
+	return b.String()
+}
+
+func writeSense(b *strings.Builder, s acronym.Sense) {
+	if warning := s.SafetyWarning(); warning != "" {
+		fmt.Fprintf(b, "\n\n⚠ **%s**", warning)
+	}
+	if s.Definition != "" {
+		fmt.Fprintf(b, "\n\n%s", s.Definition)
+	}
+	if s.Specialty != "" {
+		fmt.Fprintf(b, "\n\n_%s_", acronym.SpecialtyLabel(s.Specialty))
+	}
+}
+
+// overlaps reports whether two ranges share a position, counting touching
+// ends so a cursor beside a word selects it
+func overlaps(a, b Range) bool {
+	return !before(a.End, b.Start) && !before(b.End, a.Start)
+}
+
+func before(a, b Position) bool {
+	return a.Line < b.Line || a.Line == b.Line && a.Character < b.Character
+}
@@ -0,0 +1,197 @@
+package lsp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/anthonylangham/tmdr/internal/jsonrpc"
+)
+
+// codeServerNotInitialized answers requests sent before initialize, as LSP
+// specifies
+const codeServerNotInitialized = -32002
+
+// readFrame reads the body of one message framed with a Content-Length header
+func readFrame(r *bufio.Reader) ([]byte, error) {
+	headers, err := textproto.NewReader(r).ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+	length, err := strconv.Atoi(headers.Get("Content-Length"))
+	if err != nil || length < 0 {
+		return nil, fmt.Errorf("invalid Content-Length %q", headers.Get("Content-Length"))
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, fmt.Errorf("failed to read message body: %w", err)
+	}
+	return body, nil
+}
+
+// writeMessage writes one message framed with a Content-Length header
+func writeMessage(w io.Writer, msg *jsonrpc.Message) error {
+	body, err := jsonrpc.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Content-Length: %d\r\n\r\n", len(body)); err != nil {
+		return err
+	}
+	_, err = w.Write(body)
+	return err
+}
+
+// Position is a zero-based line and UTF-16 character offset, as LSP counts
+type Position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+type TextDocumentIdentifier struct {
+	URI string `json:"uri"`
+}
+
+type TextDocumentItem struct {
+	URI        string `json:"uri"`
+	LanguageID string `json:"languageId"`
+	Version    int    `json:"version"`
+	Text       string `json:"text"`
+}
+
+type TextDocumentPositionParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	Position     Position               `json:"position"`
+}
+
+type DidOpenTextDocumentParams struct {
+	TextDocument TextDocumentItem `json:"textDocument"`
+}
+
+type DidChangeTextDocumentParams struct {
+	TextDocument   TextDocumentIdentifier `json:"textDocument"`
+	ContentChanges []struct {
+		Text string `json:"text"`
+	} `json:"contentChanges"`
+}
+
+type DidCloseTextDocumentParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+type MarkupContent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+type Hover struct {
+	Contents MarkupContent `json:"contents"`
+	Range    *Range        `json:"range,omitempty"`
+}
+
+// Diagnostic severities
+const (
+	SeverityError   = 1
+	SeverityWarning = 2
+)
+
+type Diagnostic struct {
+	Range    Range  `json:"range"`
+	Severity int    `json:"severity"`
+	Code     string `json:"code,omitempty"`
+	Source   string `json:"source"`
+	Message  string `json:"message"`
+}
+
+type PublishDiagnosticsParams struct {
+	URI         string       `json:"uri"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+type CodeActionParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	Range        Range                  `json:"range"`
+	Context      struct {
+		Diagnostics []Diagnostic `json:"diagnostics"`
+	} `json:"context"`
+}
+
+type TextEdit struct {
+	Range   Range  `json:"range"`
+	NewText string `json:"newText"`
+}
+
+type WorkspaceEdit struct {
+	Changes map[string][]TextEdit `json:"changes"`
+}
+
+// Code action kinds
+const (
+	KindQuickFix        = "quickfix"
+	KindRefactorRewrite = "refactor.rewrite"
+)
+
+type CodeAction struct {
+	Title       string        `json:"title"`
+	Kind        string        `json:"kind"`
+	Diagnostics []Diagnostic  `json:"diagnostics,omitempty"`
+	IsPreferred bool          `json:"isPreferred,omitempty"`
+	Edit        WorkspaceEdit `json:"edit"`
+}
+
+// utf16Column converts a byte offset within a line to a UTF-16 offset
+func utf16Column(line string, offset int) int {
+	offset = min(offset, len(line))
+	column := 0
+	for _, r := range line[:offset] {
+		column += utf16Len(r)
+	}
+	return column
+}
+
+// byteOffset converts a UTF-16 offset within a line to a byte offset
+func byteOffset(line string, character int) int {
+	column := 0
+	for i, r := range line {
+		if column >= character {
+			return i
+		}
+		column += utf16Len(r)
+	}
+	return len(line)
+}
+
+func utf16Len(r rune) int {
+	if r >= 0x10000 && utf8.ValidRune(r) {
+		return 2
+	}
+	return 1
+}
+
+// lines splits a document into lines, dropping the carriage return of CRLF
+// line endings
+func lines(text string) []string {
+	split := strings.Split(text, "\n")
+	for i, line := range split {
+		split[i] = strings.TrimSuffix(line, "\r")
+	}
+	return split
+}
+
+// span returns the range of bytes start to end on a line
+func span(lineNo int, line string, start, end int) Range {
+	return Range{
+		Start: Position{Line: lineNo, Character: utf16Column(line, start)},
+		End:   Position{Line: lineNo, Character: utf16Column(line, end)},
+	}
+}
@@ -0,0 +1,243 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/check"
+	"github.com/anthonylangham/tmdr/internal/jsonrpc"
+	"github.com/anthonylangham/tmdr/internal/version"
+)
+
+// Options tunes the language server
+type Options struct {
+	// Ignore lists abbreviations not to report as do-not-use
+	Ignore []string
+}
+
+// Server is a language server that explains medical acronyms on hover,
+// warns about do-not-use abbreviations and expands acronyms in comments
+type Server struct {
+	repo    acronym.Repository
+	checker *check.Checker
+	docs    map[string]*document
+	out     io.Writer
+
+	initialized bool
+	shutdown    bool
+}
+
+// New creates a Server that looks acronyms up in repo
+func New(repo acronym.Repository, opts Options) (*Server, error) {
+	checker, err := check.New(repo, check.Options{Ignore: opts.Ignore})
+	if err != nil {
+		return nil, err
+	}
+	return &Server{repo: repo, checker: checker, docs: make(map[string]*document)}, nil
+}
+
+// errExitWithoutShutdown is returned when the client exits or hangs up
+// before asking the server to shut down
+var errExitWithoutShutdown = errors.New("client exited without a shutdown request")
+
+// Run serves requests read from r, writing responses to w, until the client
+// sends exit
+func (s *Server) Run(r io.Reader, w io.Writer) error {
+	s.out = w
+	in := bufio.NewReader(r)
+	for {
+		body, err := readFrame(in)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				if s.shutdown {
+					return nil
+				}
+				return errExitWithoutShutdown
+			}
+			return err
+		}
+
+		var msg jsonrpc.Message
+		if err := json.Unmarshal(body, &msg); err != nil {
+			if err := s.replyError(nil, jsonrpc.CodeParseError, "invalid JSON"); err != nil {
+				return err
+			}
+			continue
+		}
+		if msg.Method == "exit" {
+			if s.shutdown {
+				return nil
+			}
+			return errExitWithoutShutdown
+		}
+		if err := s.handle(&msg); err != nil {
+			return err
+		}
+	}
+}
+
+// handle dispatches one request or notification. Only failures to write to
+// the client are returned; everything else is answered with an error.
+func (s *Server) handle(msg *jsonrpc.Message) error {
+	isRequest := msg.ID != nil
+	if msg.Method == "" {
+		// A response to a request the server never makes
+		return nil
+	}
+	if !s.initialized && msg.Method != "initialize" {
+		if isRequest {
+			return s.replyError(msg.ID, codeServerNotInitialized, "server not initialized")
+		}
+		return nil
+	}
+	if s.shutdown && isRequest {
+		return s.replyError(msg.ID, jsonrpc.CodeInvalidRequest, "server is shutting down")
+	}
+
+	switch msg.Method {
+	case "initialize":
+		s.initialized = true
+		return s.reply(msg.ID, map[string]any{
+			"capabilities": map[string]any{
+				"textDocumentSync": map[string]any{"openClose": true, "change": 1},
+				"hoverProvider":    true,
+				"codeActionProvider": map[string]any{
+					"codeActionKinds": []string{KindQuickFix, KindRefactorRewrite},
+				},
+			},
+			"serverInfo": map[string]string{"name": "tmdr", "version": version.Version},
+		})
+	case "initialized":
+		return nil
+	case "shutdown":
+		s.shutdown = true
+		return s.reply(msg.ID, nil)
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil
+		}
+		item := params.TextDocument
+		return s.open(item.URI, item.LanguageID, item.Text)
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil || len(params.ContentChanges) == 0 {
+			return nil
+		}
+		languageID := ""
+		if doc, ok := s.docs[params.TextDocument.URI]; ok {
+			languageID = doc.languageID
+		}
+		// Full sync sends the whole text; the last change is the latest
+		text := params.ContentChanges[len(params.ContentChanges)-1].Text
+		return s.open(params.TextDocument.URI, languageID, text)
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil
+		}
+		delete(s.docs, params.TextDocument.URI)
+		return s.publish(params.TextDocument.URI, []Diagnostic{})
+	case "textDocument/hover":
+		var params TextDocumentPositionParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return s.replyError(msg.ID, jsonrpc.CodeInvalidParams, err.Error())
+		}
+		return s.reply(msg.ID, s.hover(params))
+	case "textDocument/codeAction":
+		var params CodeActionParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return s.replyError(msg.ID, jsonrpc.CodeInvalidParams, err.Error())
+		}
+		return s.reply(msg.ID, s.codeActions(params))
+	}
+
+	if isRequest {
+		return s.replyError(msg.ID, jsonrpc.CodeMethodNotFound, fmt.Sprintf("method %q not supported", msg.Method))
+	}
+	return nil
+}
+
+// open analyses a document's text and publishes its diagnostics
+func (s *Server) open(uri, languageID, text string) error {
+	doc := newDocument(uri, languageID, text, s.repo, s.checker)
+	s.docs[uri] = doc
+	return s.publish(uri, doc.diagnostics())
+}
+
+// hover describes the acronym under the cursor, or returns nil when there
+// is none
+func (s *Server) hover(params TextDocumentPositionParams) *Hover {
+	doc, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return nil
+	}
+	m, ok := doc.mentionAt(params.Position)
+	if !ok {
+		return nil
+	}
+	r := doc.mentionRange(m)
+	return &Hover{
+		Contents: MarkupContent{Kind: "markdown", Value: hoverText(m)},
+		Range:    &r,
+	}
+}
+
+// codeActions offers fixes for do-not-use findings and expansions of
+// acronyms in comments within the requested range
+func (s *Server) codeActions(params CodeActionParams) []CodeAction {
+	actions := []CodeAction{}
+	doc, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return actions
+	}
+
+	// A finding is a term to replace, whatever meaning its paragraph gives
+	// the mention there
+	flagged := make(map[Range]bool)
+	for _, f := range doc.findings {
+		r := doc.findingRange(f)
+		flagged[r] = true
+		if overlaps(r, params.Range) {
+			actions = append(actions, doc.fixActions(f)...)
+		}
+	}
+	for _, m := range doc.mentions {
+		r := doc.mentionRange(m)
+		if !overlaps(r, params.Range) || flagged[r] || !doc.inComment(m) {
+			continue
+		}
+		if action, ok := doc.expandAction(m); ok {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+func (s *Server) publish(uri string, diagnostics []Diagnostic) error {
+	return s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{URI: uri, Diagnostics: diagnostics})
+}
+
+func (s *Server) reply(id *json.RawMessage, result any) error {
+	msg, err := jsonrpc.Response(id, result)
+	if err != nil {
+		return err
+	}
+	return writeMessage(s.out, msg)
+}
+
+func (s *Server) replyError(id *json.RawMessage, code int, msg string) error {
+	return writeMessage(s.out, jsonrpc.ErrorResponse(id, code, msg))
+}
+
+func (s *Server) notify(method string, params any) error {
+	msg, err := jsonrpc.Notification(method, params)
+	if err != nil {
+		return err
+	}
+	return writeMessage(s.out, msg)
+}
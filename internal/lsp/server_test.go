@@ -0,0 +1,220 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/jsonrpc"
+)
+
+const uri = "file:///notes.md"
+
+// client speaks framed JSON-RPC to a server the way an editor does
+type client struct {
+	in     io.Writer
+	out    *bufio.Reader
+	nextID int
+}
+
+func (c *client) send(msg *jsonrpc.Message) error {
+	return writeMessage(c.in, msg)
+}
+
+// notify sends a notification
+func (c *client) notify(method string, params any) error {
+	msg, err := jsonrpc.Notification(method, params)
+	if err != nil {
+		return err
+	}
+	return c.send(msg)
+}
+
+// read returns the next message from the server
+func (c *client) read() (*jsonrpc.Message, error) {
+	body, err := readFrame(c.out)
+	if err != nil {
+		return nil, err
+	}
+	var msg jsonrpc.Message
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, fmt.Errorf("invalid message %s: %w", body, err)
+	}
+	return &msg, nil
+}
+
+// call sends a request, waits for its response and decodes the result into
+// result, which may be nil
+func (c *client) call(method string, params, result any) error {
+	c.nextID++
+	id := json.RawMessage(fmt.Sprint(c.nextID))
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	if err := c.send(&jsonrpc.Message{ID: &id, Method: method, Params: body}); err != nil {
+		return fmt.Errorf("failed to send %s: %w", method, err)
+	}
+
+	resp, err := c.read()
+	if err != nil {
+		return fmt.Errorf("no response to %s: %w", method, err)
+	}
+	if resp.ID == nil || string(*resp.ID) != string(id) {
+		return fmt.Errorf("response to %s is not for request %s", method, id)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s failed: %s", method, resp.Error.Message)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(resp.Result, result)
+}
+
+// diagnostics reads the diagnostics the server publishes for a document
+func (c *client) diagnostics() ([]Diagnostic, error) {
+	msg, err := c.read()
+	if err != nil {
+		return nil, err
+	}
+	if msg.Method != "textDocument/publishDiagnostics" {
+		return nil, fmt.Errorf("got %q, want diagnostics", msg.Method)
+	}
+	var params PublishDiagnosticsParams
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		return nil, err
+	}
+	if params.URI != uri {
+		return nil, fmt.Errorf("diagnostics for %s, want %s", params.URI, uri)
+	}
+	return params.Diagnostics, nil
+}
+
+// open sends a document's text and returns the diagnostics published for it
+func (c *client) open(text string) ([]Diagnostic, error) {
+	err := c.notify("textDocument/didOpen", DidOpenTextDocumentParams{
+		TextDocument: TextDocumentItem{URI: uri, LanguageID: "markdown", Version: 1, Text: text},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.diagnostics()
+}
+
+// TestSession drives Server.Run over pipes with an editor's session: the
+// handshake, diagnostics as a document is opened, hover, code actions and
+// shutdown
+func TestSession(t *testing.T) {
+	repo, err := acronym.NewEmbeddedRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, err := New(repo, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests, clientIn := io.Pipe()
+	serverOut, responses := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := server.Run(requests, responses)
+		responses.Close()
+		done <- err
+	}()
+	c := &client{in: clientIn, out: bufio.NewReader(serverOut)}
+
+	if err := c.call("hover", TextDocumentPositionParams{}, nil); err == nil || !strings.Contains(err.Error(), "not initialized") {
+		t.Errorf("request before initialize: %v, want not initialized", err)
+	}
+	var initResult struct {
+		Capabilities struct {
+			HoverProvider bool `json:"hoverProvider"`
+		} `json:"capabilities"`
+	}
+	if err := c.call("initialize", map[string]any{}, &initResult); err != nil {
+		t.Fatal(err)
+	}
+	if !initResult.Capabilities.HoverProvider {
+		t.Error("initialize did not offer hover")
+	}
+	if err := c.notify("initialized", map[string]any{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The morphine dose is reported; the mention of MS with MRI lesions,
+	// in the next sentence, is not
+	diagnostics, err := c.open("Give MS 5 mg IV. MS with MRI lesions.\nCheck the ABG.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diagnostics) != 1 {
+		t.Fatalf("got %d diagnostics, want 1: %+v", len(diagnostics), diagnostics)
+	}
+	want := Range{Start: Position{0, 5}, End: Position{0, 7}}
+	if d := diagnostics[0]; d.Range != want || d.Code != "do-not-use" || !strings.Contains(d.Message, "Morphine Sulfate") {
+		t.Errorf("diagnostic %+v, want MS as morphine at %+v", d, want)
+	}
+
+	var hover Hover
+	err = c.call("textDocument/hover", TextDocumentPositionParams{
+		TextDocument: TextDocumentIdentifier{URI: uri},
+		Position:     Position{Line: 1, Character: 11},
+	}, &hover)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(hover.Contents.Value, "Arterial Blood Gas") {
+		t.Errorf("hover over ABG = %q", hover.Contents.Value)
+	}
+
+	var actions []CodeAction
+	err = c.call("textDocument/codeAction", CodeActionParams{
+		TextDocument: TextDocumentIdentifier{URI: uri},
+		Range:        want,
+	}, &actions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 1 || actions[0].Kind != KindQuickFix || actions[0].Edit.Changes[uri][0].NewText != "morphine sulfate" {
+		t.Errorf("code actions for MS = %+v, want a fix writing morphine sulfate", actions)
+	}
+
+	if err := c.notify("textDocument/didClose", DidCloseTextDocumentParams{TextDocument: TextDocumentIdentifier{URI: uri}}); err != nil {
+		t.Fatal(err)
+	}
+	if diagnostics, err := c.diagnostics(); err != nil || len(diagnostics) != 0 {
+		t.Errorf("closing the document published %v, %v; want no diagnostics", diagnostics, err)
+	}
+
+	if err := c.call("shutdown", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.notify("exit", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("Run returned %v after shutdown and exit", err)
+	}
+}
+
+// TestExitWithoutShutdown checks that an editor hanging up without shutting
+// the server down is reported
+func TestExitWithoutShutdown(t *testing.T) {
+	repo, err := acronym.NewEmbeddedRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, err := New(repo, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := server.Run(strings.NewReader(""), io.Discard); err != errExitWithoutShutdown {
+		t.Errorf("Run = %v, want %v", err, errExitWithoutShutdown)
+	}
+}
@@ -0,0 +1,128 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/jsonrpc"
+	"github.com/anthonylangham/tmdr/internal/version"
+)
+
+// ProtocolVersions lists the Model Context Protocol revisions the server
+// speaks, newest first
+var ProtocolVersions = []string{"2025-06-18", "2025-03-26", "2024-11-05"}
+
+// Server is a Model Context Protocol server that gives AI assistants tools
+// to look acronyms up instead of guessing what they mean
+type Server struct {
+	repo acronym.Repository
+	out  io.Writer
+}
+
+// New creates a Server whose tools are backed by repo
+func New(repo acronym.Repository) *Server {
+	return &Server{repo: repo}
+}
+
+// Run serves newline-delimited JSON-RPC messages read from r, writing
+// responses to w, until r is closed
+func (s *Server) Run(r io.Reader, w io.Writer) error {
+	s.out = w
+	in := bufio.NewReader(r)
+	for {
+		line, err := in.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			if err := s.handleLine(line); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read request: %w", err)
+		}
+	}
+}
+
+// handleLine answers one message. Only failures to write to the client are
+// returned; everything else is answered with an error.
+func (s *Server) handleLine(line []byte) error {
+	var msg jsonrpc.Message
+	if err := json.Unmarshal(line, &msg); err != nil {
+		return s.replyError(nil, jsonrpc.CodeParseError, "invalid JSON")
+	}
+	if msg.ID == nil {
+		// Notifications such as notifications/initialized need no answer
+		return nil
+	}
+
+	switch msg.Method {
+	case "initialize":
+		var params struct {
+			ProtocolVersion string `json:"protocolVersion"`
+		}
+		json.Unmarshal(msg.Params, &params)
+		return s.reply(msg.ID, map[string]any{
+			"protocolVersion": negotiate(params.ProtocolVersion),
+			"capabilities":    map[string]any{"tools": map[string]any{}},
+			"serverInfo":      map[string]string{"name": "tmdr", "version": version.Version},
+			"instructions":    "Look up medical acronyms and abbreviations here instead of guessing what they mean.",
+		})
+	case "ping":
+		return s.reply(msg.ID, map[string]any{})
+	case "tools/list":
+		return s.reply(msg.ID, map[string]any{"tools": tools})
+	case "tools/call":
+		var params struct {
+			Name      string          `json:"name"`
+			Arguments json.RawMessage `json:"arguments"`
+		}
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return s.replyError(msg.ID, jsonrpc.CodeInvalidParams, err.Error())
+		}
+		t, ok := findTool(params.Name)
+		if !ok {
+			return s.replyError(msg.ID, jsonrpc.CodeInvalidParams, fmt.Sprintf("unknown tool %q", params.Name))
+		}
+		return s.reply(msg.ID, t.call(s, params.Arguments))
+	}
+	return s.replyError(msg.ID, jsonrpc.CodeMethodNotFound, fmt.Sprintf("method %q not supported", msg.Method))
+}
+
+// negotiate answers with the client's protocol version when the server
+// speaks it, and the newest version otherwise
+func negotiate(requested string) string {
+	if slices.Contains(ProtocolVersions, requested) {
+		return requested
+	}
+	return ProtocolVersions[0]
+}
+
+func (s *Server) reply(id *json.RawMessage, result any) error {
+	msg, err := jsonrpc.Response(id, result)
+	if err != nil {
+		return err
+	}
+	return s.write(msg)
+}
+
+func (s *Server) replyError(id *json.RawMessage, code int, msg string) error {
+	return s.write(jsonrpc.ErrorResponse(id, code, msg))
+}
+
+// write sends one message on its own line
+func (s *Server) write(msg *jsonrpc.Message) error {
+	body, err := jsonrpc.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = s.out.Write(append(body, '\n'))
+	return err
+}
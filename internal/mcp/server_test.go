@@ -0,0 +1,252 @@
+package mcp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/jsonrpc"
+)
+
+// response is a JSON-RPC response from the server
+type response struct {
+	ID     int             `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// resultText joins the text content of a tool result
+func resultText(r toolResult) string {
+	var parts []string
+	for _, c := range r.Content {
+		parts = append(parts, c.Text)
+	}
+	return strings.Join(parts, "\n")
+}
+
+// client speaks newline-delimited JSON-RPC to a server the way an AI
+// assistant does
+type client struct {
+	in     io.Writer
+	out    *bufio.Reader
+	nextID int
+}
+
+func (c *client) send(v any) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = c.in.Write(append(body, '\n'))
+	return err
+}
+
+// call sends a request and waits for its response
+func (c *client) call(method string, params any) (response, error) {
+	c.nextID++
+	id := c.nextID
+	if err := c.send(map[string]any{"jsonrpc": "2.0", "id": id, "method": method, "params": params}); err != nil {
+		return response{}, fmt.Errorf("failed to send %s: %w", method, err)
+	}
+
+	line, err := c.out.ReadBytes('\n')
+	if err != nil {
+		return response{}, fmt.Errorf("no response to %s: %w", method, err)
+	}
+	var resp response
+	if err := json.Unmarshal(line, &resp); err != nil {
+		return response{}, fmt.Errorf("invalid response to %s: %w", method, err)
+	}
+	if resp.ID != id {
+		return response{}, fmt.Errorf("response id %d, want %d", resp.ID, id)
+	}
+	return resp, nil
+}
+
+// callTool calls a tool and decodes its result
+func (c *client) callTool(name string, args map[string]any) (toolResult, error) {
+	resp, err := c.call("tools/call", map[string]any{"name": name, "arguments": args})
+	if err != nil {
+		return toolResult{}, err
+	}
+	if resp.Error != nil {
+		return toolResult{}, fmt.Errorf("%s failed: %s", name, resp.Error.Message)
+	}
+	var result toolResult
+	if err := json.Unmarshal(resp.Result, &result); err != nil {
+		return toolResult{}, fmt.Errorf("invalid %s result: %w", name, err)
+	}
+	return result, nil
+}
+
+// check is one step of the session, run in order
+type check struct {
+	name string
+	run  func(c *client) error
+}
+
+var checks = []check{
+	{"initialize", func(c *client) error {
+		resp, err := c.call("initialize", map[string]any{
+			"protocolVersion": "2025-06-18",
+			"capabilities":    map[string]any{},
+			"clientInfo":      map[string]string{"name": "test", "version": "1"},
+		})
+		if err != nil {
+			return err
+		}
+		var result struct {
+			ProtocolVersion string `json:"protocolVersion"`
+			Capabilities    struct {
+				Tools *json.RawMessage `json:"tools"`
+			} `json:"capabilities"`
+		}
+		if err := json.Unmarshal(resp.Result, &result); err != nil {
+			return err
+		}
+		if result.ProtocolVersion != "2025-06-18" {
+			return fmt.Errorf("protocol version %q, want 2025-06-18", result.ProtocolVersion)
+		}
+		if result.Capabilities.Tools == nil {
+			return fmt.Errorf("tools capability missing")
+		}
+		return c.send(map[string]any{"jsonrpc": "2.0", "method": "notifications/initialized"})
+	}},
+	{"ping", func(c *client) error {
+		_, err := c.call("ping", nil)
+		return err
+	}},
+	{"tools/list has a schema for each tool", func(c *client) error {
+		resp, err := c.call("tools/list", map[string]any{})
+		if err != nil {
+			return err
+		}
+		var result struct {
+			Tools []struct {
+				Name        string `json:"name"`
+				Description string `json:"description"`
+				InputSchema struct {
+					Type       string                     `json:"type"`
+					Properties map[string]json.RawMessage `json:"properties"`
+					Required   []string                   `json:"required"`
+				} `json:"inputSchema"`
+			} `json:"tools"`
+		}
+		if err := json.Unmarshal(resp.Result, &result); err != nil {
+			return err
+		}
+		want := map[string]bool{"lookup": true, "fuzzy": true, "search": true, "expand": true}
+		for _, t := range result.Tools {
+			if t.InputSchema.Type != "object" || len(t.InputSchema.Properties) == 0 {
+				return fmt.Errorf("tool %s has no object schema", t.Name)
+			}
+			for _, name := range t.InputSchema.Required {
+				if _, ok := t.InputSchema.Properties[name]; !ok {
+					return fmt.Errorf("tool %s requires undeclared %q", t.Name, name)
+				}
+			}
+			if t.Description == "" {
+				return fmt.Errorf("tool %s has no description", t.Name)
+			}
+			delete(want, t.Name)
+		}
+		if len(want) > 0 {
+			return fmt.Errorf("missing tools: %v", want)
+		}
+		return nil
+	}},
+	{"lookup finds ABG", expectText("lookup", map[string]any{"acronym": "abg"}, false, "Arterial Blood Gas")},
+	{"lookup ranks by context", expectText("lookup", map[string]any{"acronym": "MS", "context": "diastolic murmur on echo"}, false, `"senses":[{"full_form":"Mitral Stenosis"`)},
+	{"lookup reports an unknown acronym", expectText("lookup", map[string]any{"acronym": "ABGX"}, true, "not found")},
+	{"fuzzy suggests for a typo", expectText("fuzzy", map[string]any{"query": "abx", "limit": 3}, false, `"acronym":"ABG"`)},
+	{"search ranks by meaning", expectText("search", map[string]any{"query": "blood gas"}, false, `"acronym":"ABG"`)},
+	{"expand annotates text", expectText("expand", map[string]any{"text": "Check the ABG."}, false, "ABG (Arterial Blood Gas)")},
+	{"arguments are checked against the schema", expectText("search", map[string]any{"q": "blood"}, true, "invalid arguments")},
+	{"unknown tools are refused", func(c *client) error {
+		resp, err := c.call("tools/call", map[string]any{"name": "diagnose", "arguments": map[string]any{}})
+		if err != nil {
+			return err
+		}
+		if resp.Error == nil {
+			return fmt.Errorf("expected an error")
+		}
+		return nil
+	}},
+	{"invalid JSON is answered with a parse error", func(c *client) error {
+		if _, err := io.WriteString(c.in, "{\"jsonrpc\": \n"); err != nil {
+			return err
+		}
+		line, err := c.out.ReadBytes('\n')
+		if err != nil {
+			return err
+		}
+		var resp struct {
+			ID    *int `json:"id"`
+			Error *struct {
+				Code int `json:"code"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal(line, &resp); err != nil {
+			return err
+		}
+		if resp.ID != nil || resp.Error == nil || resp.Error.Code != jsonrpc.CodeParseError {
+			return fmt.Errorf("got %s, want a parse error with a null id", line)
+		}
+		return nil
+	}},
+}
+
+// expectText checks that a tool answers with text containing want
+func expectText(name string, args map[string]any, isError bool, want string) func(c *client) error {
+	return func(c *client) error {
+		result, err := c.callTool(name, args)
+		if err != nil {
+			return err
+		}
+		if result.IsError != isError {
+			return fmt.Errorf("isError = %v, want %v: %s", result.IsError, isError, resultText(result))
+		}
+		if !strings.Contains(resultText(result), want) {
+			return fmt.Errorf("result does not contain %q: %s", want, resultText(result))
+		}
+		return nil
+	}
+}
+
+// TestSession drives Server.Run over pipes with a session like an AI
+// assistant's: the handshake, the tool schemas and a call to each tool
+func TestSession(t *testing.T) {
+	repo, err := acronym.NewEmbeddedRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests, clientIn := io.Pipe()
+	serverOut, responses := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := New(repo).Run(requests, responses)
+		responses.Close()
+		done <- err
+	}()
+
+	c := &client{in: clientIn, out: bufio.NewReader(serverOut)}
+	for _, ch := range checks {
+		if err := ch.run(c); err != nil {
+			t.Errorf("%s: %v", ch.name, err)
+		}
+	}
+
+	// Closing stdin is how a stdio MCP client ends the session
+	clientIn.Close()
+	if err := <-done; err != nil {
+		t.Errorf("Run returned %v after the client hung up", err)
+	}
+}
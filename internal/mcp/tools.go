@@ -0,0 +1,268 @@
+package mcp
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/expand"
+	"github.com/anthonylangham/tmdr/internal/output"
+)
+
+// Defaults and caps for the ranked tools
+const (
+	defaultFuzzyLimit  = 5
+	defaultSearchLimit = 10
+	maxLimit           = 50
+)
+
+// tool is an MCP tool with the JSON schema of its arguments
+type tool struct {
+	Name        string          `json:"name"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	InputSchema json.RawMessage `json:"inputSchema"`
+	call        func(s *Server, args json.RawMessage) toolResult
+}
+
+// tools lists every tool the server offers
+var tools = []tool{
+	{
+		Name:        "lookup",
+		Title:       "Look up a medical acronym",
+		Description: "Returns every meaning of a medical acronym or abbreviation, with definitions, specialties and do-not-use warnings. Pass the sentence it came from as context to rank the meanings. Fails with close suggestions when the acronym is unknown.",
+		InputSchema: json.RawMessage(`{
+			"type": "object",
+			"properties": {
+				"acronym": {"type": "string", "description": "The acronym as written, such as ABG or HbA1c"},
+				"context": {"type": "string", "description": "Text around the acronym, used to rank its meanings"}
+			},
+			"required": ["acronym"],
+			"additionalProperties": false
+		}`),
+		call: (*Server).lookup,
+	},
+	{
+		Name:        "fuzzy",
+		Title:       "Suggest acronyms for a misspelling",
+		Description: "Returns the known acronyms closest to a possibly misspelled one, best first.",
+		InputSchema: json.RawMessage(`{
+			"type": "object",
+			"properties": {
+				"query": {"type": "string", "description": "The acronym as written"},
+				"limit": {"type": "integer", "minimum": 1, "maximum": 50, "default": 5, "description": "How many suggestions to return"}
+			},
+			"required": ["query"],
+			"additionalProperties": false
+		}`),
+		call: (*Server).fuzzy,
+	},
+	{
+		Name:        "search",
+		Title:       "Search acronyms by meaning",
+		Description: "Full-text search over full forms, synonyms, definitions and specialties, such as \"blood gas\" or \"kidney\". Returns matching acronyms ranked by relevance.",
+		InputSchema: json.RawMessage(`{
+			"type": "object",
+			"properties": {
+				"query": {"type": "string", "description": "Words to search for"},
+				"limit": {"type": "integer", "minimum": 1, "maximum": 50, "default": 10, "description": "How many results to return"},
+				"prefix": {"type": "boolean", "default": false, "description": "Also match words starting with the last query word"},
+				"fuzzy": {"type": "boolean", "default": false, "description": "Also match words a typo or two away"}
+			},
+			"required": ["query"],
+			"additionalProperties": false
+		}`),
+		call: (*Server).search,
+	},
+	{
+		Name:        "expand",
+		Title:       "Expand acronyms in text",
+		Description: "Returns the text with each known acronym spelled out at its first use, using the meaning the surrounding words point to. Code blocks are left alone.",
+		InputSchema: json.RawMessage(`{
+			"type": "object",
+			"properties": {
+				"text": {"type": "string", "description": "Text or Markdown to annotate"},
+				"style": {"type": "string", "enum": ["inline", "footnote", "glossary"], "default": "inline", "description": "inline writes ABG (Arterial Blood Gas), footnote adds Markdown footnotes, glossary appends a glossary"}
+			},
+			"required": ["text"],
+			"additionalProperties": false
+		}`),
+		call: (*Server).expand,
+	},
+}
+
+func findTool(name string) (tool, bool) {
+	for _, t := range tools {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return tool{}, false
+}
+
+// toolResult is the outcome of a tool call. Failures the assistant can act
+// on, such as an unknown acronym, are results with IsError set rather than
+// protocol errors.
+type toolResult struct {
+	Content []content `json:"content"`
+	IsError bool      `json:"isError,omitempty"`
+}
+
+type content struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+func textResult(text string) toolResult {
+	return toolResult{Content: []content{{Type: "text", Text: text}}}
+}
+
+func errorResult(format string, args ...any) toolResult {
+	r := textResult(fmt.Sprintf(format, args...))
+	r.IsError = true
+	return r
+}
+
+// jsonResult returns v as compact JSON, the same shape as --format json
+func jsonResult(v any) toolResult {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return errorResult("failed to encode result: %v", err)
+	}
+	return textResult(string(body))
+}
+
+// decodeArgs decodes tool arguments, refusing ones the schema doesn't list
+func decodeArgs(raw json.RawMessage, v any) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		raw = json.RawMessage("{}")
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+	return nil
+}
+
+// limit applies a default to an unset limit and caps it
+func limit(n, fallback int) int {
+	if n <= 0 {
+		return fallback
+	}
+	return min(n, maxLimit)
+}
+
+// notFound is the result for an acronym that isn't in the dictionary
+type notFound struct {
+	Error       string          `json:"error"`
+	Suggestions []output.Result `json:"suggestions"`
+}
+
+func (s *Server) lookup(raw json.RawMessage) toolResult {
+	var args struct {
+		Acronym string `json:"acronym"`
+		Context string `json:"context"`
+	}
+	if err := decodeArgs(raw, &args); err != nil {
+		return errorResult("%v", err)
+	}
+	term := strings.TrimSpace(args.Acronym)
+	if term == "" {
+		return errorResult("acronym is required")
+	}
+
+	a, err := s.repo.Find(term)
+	if errors.Is(err, acronym.ErrNotFound) {
+		suggestions := []output.Result{}
+		if matches, err := s.repo.FindFuzzy(term, 3); err == nil {
+			suggestions = results(term, matches)
+		}
+		r := jsonResult(notFound{Error: fmt.Sprintf("acronym '%s' not found", term), Suggestions: suggestions})
+		r.IsError = true
+		return r
+	}
+	if err != nil {
+		return errorResult("lookup failed: %v", err)
+	}
+
+	match := acronym.Match{Acronym: *a, Type: acronym.MatchExact, Score: 1}
+	if args.Context != "" {
+		return jsonResult(output.NewRankedResult(term, match, a.Disambiguate(args.Context)))
+	}
+	return jsonResult(output.NewResult(term, match))
+}
+
+func (s *Server) fuzzy(raw json.RawMessage) toolResult {
+	var args struct {
+		Query string `json:"query"`
+		Limit int    `json:"limit"`
+	}
+	if err := decodeArgs(raw, &args); err != nil {
+		return errorResult("%v", err)
+	}
+	if strings.TrimSpace(args.Query) == "" {
+		return errorResult("query is required")
+	}
+
+	matches, err := s.repo.FindFuzzy(args.Query, limit(args.Limit, defaultFuzzyLimit))
+	if errors.Is(err, acronym.ErrNoFuzzyMatch) {
+		// No suggestions is an empty result, not an error
+		matches = nil
+	} else if err != nil {
+		return errorResult("fuzzy lookup failed: %v", err)
+	}
+	return jsonResult(results(args.Query, matches))
+}
+
+func (s *Server) search(raw json.RawMessage) toolResult {
+	var args struct {
+		Query  string `json:"query"`
+		Limit  int    `json:"limit"`
+		Prefix bool   `json:"prefix"`
+		Fuzzy  bool   `json:"fuzzy"`
+	}
+	if err := decodeArgs(raw, &args); err != nil {
+		return errorResult("%v", err)
+	}
+	if strings.TrimSpace(args.Query) == "" {
+		return errorResult("query is required")
+	}
+
+	matches, err := s.repo.Search(args.Query, acronym.SearchOptions{
+		Limit:  limit(args.Limit, defaultSearchLimit),
+		Prefix: args.Prefix,
+		Fuzzy:  args.Fuzzy,
+	})
+	if err != nil {
+		return errorResult("search failed: %v", err)
+	}
+	return jsonResult(results(args.Query, matches))
+}
+
+func (s *Server) expand(raw json.RawMessage) toolResult {
+	var args struct {
+		Text  string `json:"text"`
+		Style string `json:"style"`
+	}
+	if err := decodeArgs(raw, &args); err != nil {
+		return errorResult("%v", err)
+	}
+
+	expanded, err := expand.Expand(args.Text, s.repo, expand.Options{Style: expand.Style(args.Style)})
+	if err != nil {
+		return errorResult("%v", err)
+	}
+	return textResult(expanded)
+}
+
+func results(query string, matches []acronym.Match) []output.Result {
+	converted := make([]output.Result, len(matches))
+	for i, m := range matches {
+		converted[i] = output.NewResult(query, m)
+	}
+	return converted
+}
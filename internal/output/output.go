@@ -0,0 +1,219 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats accepted by --format
+const (
+	FormatText     = "text"
+	FormatJSON     = "json"
+	FormatYAML     = "yaml"
+	FormatTSV      = "tsv"
+	FormatMarkdown = "markdown"
+)
+
+// Formats lists every supported output format
+var Formats = []string{FormatText, FormatJSON, FormatYAML, FormatTSV, FormatMarkdown}
+
+// Result is a lookup result in the shape emitted by the machine-readable formats
+type Result struct {
+	Query   string  `json:"query" yaml:"query"`
+	Match   string  `json:"match" yaml:"match"`
+	Score   float64 `json:"score" yaml:"score"`
+	Acronym string  `json:"acronym" yaml:"acronym"`
+	Senses  []Sense `json:"senses" yaml:"senses"`
+}
+
+// Sense is one meaning of a result's acronym
+type Sense struct {
+	FullForm    string   `json:"full_form" yaml:"full_form"`
+	Definition  string   `json:"definition,omitempty" yaml:"definition,omitempty"`
+	Specialty   string   `json:"specialty,omitempty" yaml:"specialty,omitempty"`
+	Synonyms    []string `json:"synonyms,omitempty" yaml:"synonyms,omitempty"`
+	References  []string `json:"references,omitempty" yaml:"references,omitempty"`
+	Source      string   `json:"source,omitempty" yaml:"source,omitempty"`
+	DoNotUse    bool     `json:"do_not_use,omitempty" yaml:"do_not_use,omitempty"`
+	Alternative string   `json:"alternative,omitempty" yaml:"alternative,omitempty"`
+	// Relevance and Cues are set when senses were ranked against a context
+	Relevance float64  `json:"relevance,omitempty" yaml:"relevance,omitempty"`
+	Cues      []string `json:"cues,omitempty" yaml:"cues,omitempty"`
+}
+
+// NewResult converts a repository match into a Result for query
+func NewResult(query string, m acronym.Match) Result {
+	result := Result{
+		Query:   query,
+		Match:   string(m.Type),
+		Score:   m.Score,
+		Acronym: m.Acronym.Acronym,
+		Senses:  make([]Sense, len(m.Acronym.Senses)),
+	}
+	for i, s := range m.Acronym.Senses {
+		result.Senses[i] = newSense(s)
+	}
+	return result
+}
+
+// NewRankedResult converts a match whose senses were ranked against the text
+// around it, listing senses in ranked order with the cues that matched
+func NewRankedResult(query string, m acronym.Match, ranked []acronym.RankedSense) Result {
+	result := NewResult(query, m)
+	result.Senses = make([]Sense, len(ranked))
+	for i, r := range ranked {
+		result.Senses[i] = newSense(r.Sense)
+		result.Senses[i].Relevance = r.Score
+		result.Senses[i].Cues = r.Cues
+	}
+	return result
+}
+
+func newSense(s acronym.Sense) Sense {
+	return Sense{
+		FullForm:    s.FullForm,
+		Definition:  s.Definition,
+		Specialty:   s.Specialty,
+		Synonyms:    s.Synonyms,
+		References:  s.References,
+		Source:      s.SourceLabel(),
+		DoNotUse:    s.DoNotUse,
+		Alternative: s.Alternative,
+	}
+}
+
+// IsValidFormat reports whether format is a supported output format
+func IsValidFormat(format string) bool {
+	for _, f := range Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// Write renders results in one of the machine-readable formats
+func Write(w io.Writer, format string, results []Result) error {
+	if results == nil {
+		results = []Result{}
+	}
+
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(results)
+	case FormatYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(results); err != nil {
+			return err
+		}
+		return enc.Close()
+	case FormatTSV:
+		return writeTSV(w, results)
+	case FormatMarkdown:
+		return writeMarkdown(w, results)
+	default:
+		return fmt.Errorf("unsupported output format %q (want one of %s)", format, strings.Join(Formats, ", "))
+	}
+}
+
+// WriteTemplate renders each result with a Go text/template. A newline is
+// added after each result unless its rendering ends with one.
+func WriteTemplate(w io.Writer, text string, results []Result) error {
+	tmpl, err := template.New("result").Funcs(template.FuncMap{
+		"join":  strings.Join,
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+	}).Parse(text)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	for _, result := range results {
+		buf.Reset()
+		if err := tmpl.Execute(&buf, result); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+		if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeTSV writes one row per sense with a header row
+func writeTSV(w io.Writer, results []Result) error {
+	if _, err := fmt.Fprintln(w, "query\tmatch\tscore\tacronym\trank\tfull_form\tdefinition\tsource"); err != nil {
+		return err
+	}
+	for _, r := range results {
+		for i, s := range r.Senses {
+			_, err := fmt.Fprintf(w, "%s\t%s\t%.2f\t%s\t%d\t%s\t%s\t%s\n",
+				tsvField(r.Query), r.Match, r.Score, tsvField(r.Acronym), i+1,
+				tsvField(s.FullForm), tsvField(s.Definition), tsvField(s.Source))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// tsvField flattens tabs and newlines so a value stays in its column
+func tsvField(s string) string {
+	return strings.NewReplacer("\t", " ", "\r", " ", "\n", " ").Replace(s)
+}
+
+// writeMarkdown writes a heading per result with its senses underneath
+func writeMarkdown(w io.Writer, results []Result) error {
+	var b strings.Builder
+	for i, r := range results {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "## %s\n\n", r.Acronym)
+		// Random results have no query
+		if r.Query == "" {
+			fmt.Fprintf(&b, "_%s match, score %.2f_\n\n", r.Match, r.Score)
+		} else {
+			fmt.Fprintf(&b, "_%s match for `%s`, score %.2f_\n\n", r.Match, r.Query, r.Score)
+		}
+		for j, s := range r.Senses {
+			prefix := "-"
+			if len(r.Senses) > 1 {
+				prefix = fmt.Sprintf("%d.", j+1)
+			}
+			fmt.Fprintf(&b, "%s **%s**", prefix, s.FullForm)
+			if s.Definition != "" {
+				fmt.Fprintf(&b, ": %s", s.Definition)
+			}
+			if s.DoNotUse && s.Alternative != "" {
+				fmt.Fprintf(&b, " **⚠ do not use; write %q instead**", s.Alternative)
+			} else if s.DoNotUse {
+				b.WriteString(" **⚠ do not use**")
+			}
+			if s.Source != "" {
+				fmt.Fprintf(&b, " _(from %s)_", s.Source)
+			}
+			if len(s.Cues) > 0 {
+				fmt.Fprintf(&b, " _(matched %s)_", strings.Join(s.Cues, ", "))
+			}
+			b.WriteString("\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
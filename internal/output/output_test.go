@@ -0,0 +1,48 @@
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWriteTemplate(t *testing.T) {
+	results := []Result{
+		{Acronym: "ABG", Senses: []Sense{{FullForm: "Arterial Blood Gas"}}},
+		{Acronym: "BP", Senses: []Sense{{FullForm: "Blood Pressure"}}},
+	}
+
+	tests := []struct {
+		name, template, want string
+	}{
+		{"no newline", "{{.Acronym}}", "ABG\nBP\n"},
+		{"newline in the source", "{{.Acronym}}\n", "ABG\nBP\n"},
+		{"newline rendered", `{{.Acronym}}{{"\n"}}`, "ABG\nBP\n"},
+		{"newline from a range", "{{range .Senses}}{{.FullForm}}\n{{end}}", "Arterial Blood Gas\nBlood Pressure\n"},
+		{"newline inside only", "{{.Acronym}}\n{{with .Senses}}{{(index . 0).FullForm}}{{end}}", "ABG\nArterial Blood Gas\nBP\nBlood Pressure\n"},
+		{"trimmed newline", "{{.Acronym}}\n{{- /* no newline */ -}}", "ABG\nBP\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			if err := WriteTemplate(&b, tt.template, results); err != nil {
+				t.Fatal(err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("WriteTemplate(%q) = %q, want %q", tt.template, b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteTemplateErrors(t *testing.T) {
+	var b strings.Builder
+	if err := WriteTemplate(&b, "{{.Acronym", nil); err == nil {
+		t.Error("WriteTemplate accepted an unclosed action")
+	}
+	if err := WriteTemplate(&b, "{{.Missing}}", []Result{{Acronym: "ABG"}}); err == nil {
+		t.Error("WriteTemplate rendered a field Result doesn't have")
+	}
+	if b.Len() != 0 {
+		t.Errorf("failed templates wrote %q", b.String())
+	}
+}
@@ -0,0 +1,436 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/expand"
+	"github.com/anthonylangham/tmdr/internal/output"
+)
+
+// DefaultAddr is where tmdr serve listens unless told otherwise. It only
+// accepts connections from the local machine.
+const DefaultAddr = "127.0.0.1:8080"
+
+// Defaults for paginated and ranked endpoints
+const (
+	defaultPerPage     = 50
+	maxPerPage         = 500
+	defaultFuzzyLimit  = 3
+	defaultSearchLimit = 10
+)
+
+// maxExpandBody caps the text /expand accepts, in bytes
+const maxExpandBody = 1 << 20
+
+// shutdownTimeout is how long in-flight requests get to finish on shutdown
+const shutdownTimeout = 5 * time.Second
+
+// Options configures the server
+type Options struct {
+	// AllowOrigins lists the origins browsers may call the API from, or "*"
+	// for any. Cross-origin requests are refused when empty.
+	AllowOrigins []string
+}
+
+// Server serves the acronym repository as a JSON API. It is an http.Handler,
+// so it can be tested with httptest without listening on a port.
+type Server struct {
+	repo    acronym.Repository
+	opts    Options
+	handler http.Handler
+}
+
+// New creates a Server backed by repo
+func New(repo acronym.Repository, opts Options) *Server {
+	s := &Server{repo: repo, opts: opts}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /lookup/{acronym}", s.lookup)
+	mux.HandleFunc("GET /fuzzy", s.fuzzy)
+	mux.HandleFunc("GET /search", s.search)
+	mux.HandleFunc("GET /random", s.random)
+	mux.HandleFunc("GET /all", s.all)
+	mux.HandleFunc("POST /expand", s.expand)
+	s.handler = s.cors(mux)
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.handler.ServeHTTP(w, r)
+}
+
+// ListenAndServe serves on addr until ctx is cancelled, then stops accepting
+// connections and waits for in-flight requests to finish
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	return s.Serve(ctx, listener)
+}
+
+// Serve serves on listener until ctx is cancelled, like ListenAndServe
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	srv := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- srv.Serve(listener)
+	}()
+
+	select {
+	case err := <-errs:
+		return fmt.Errorf("server stopped: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down: %w", err)
+	}
+	if err := <-errs; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// notFoundResponse is returned for an acronym that isn't in the dictionary
+type notFoundResponse struct {
+	Error       string          `json:"error"`
+	Suggestions []output.Result `json:"suggestions"`
+}
+
+// lookup returns an acronym with every sense. With ?context= the senses are
+// ranked by the words in the given text.
+func (s *Server) lookup(w http.ResponseWriter, r *http.Request) {
+	term := r.PathValue("acronym")
+	a, err := s.repo.Find(term)
+	if errors.Is(err, acronym.ErrNotFound) {
+		suggestions := []output.Result{}
+		if matches, err := s.repo.FindFuzzy(term, defaultFuzzyLimit); err == nil {
+			suggestions = results(term, matches)
+		}
+		writeJSON(w, r, http.StatusNotFound, notFoundResponse{
+			Error:       fmt.Sprintf("acronym '%s' not found", term),
+			Suggestions: suggestions,
+		})
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	match := acronym.Match{Acronym: *a, Type: acronym.MatchExact, Score: 1}
+	if context := r.URL.Query().Get("context"); context != "" {
+		writeJSON(w, r, http.StatusOK, output.NewRankedResult(term, match, a.Disambiguate(context)))
+		return
+	}
+	writeJSON(w, r, http.StatusOK, output.NewResult(term, match))
+}
+
+// fuzzy returns the acronyms closest to ?q=, up to ?limit=
+func (s *Server) fuzzy(w http.ResponseWriter, r *http.Request) {
+	query, ok := requireQuery(w, r)
+	if !ok {
+		return
+	}
+	limit, ok := intParam(w, r, "limit", defaultFuzzyLimit)
+	if !ok {
+		return
+	}
+
+	matches, err := s.repo.FindFuzzy(query, limit)
+	if errors.Is(err, acronym.ErrNoFuzzyMatch) {
+		// No suggestions is an empty result, not an error
+		matches = nil
+	} else if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, r, http.StatusOK, results(query, matches))
+}
+
+// search ranks acronyms against the words in ?q=, up to ?limit=. Set
+// ?prefix=true for search-as-you-type and ?fuzzy=true to tolerate typos.
+func (s *Server) search(w http.ResponseWriter, r *http.Request) {
+	query, ok := requireQuery(w, r)
+	if !ok {
+		return
+	}
+	limit, ok := intParam(w, r, "limit", defaultSearchLimit)
+	if !ok {
+		return
+	}
+
+	params := r.URL.Query()
+	matches, err := s.repo.Search(query, acronym.SearchOptions{
+		Limit:  limit,
+		Prefix: params.Get("prefix") == "true",
+		Fuzzy:  params.Get("fuzzy") == "true",
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, r, http.StatusOK, results(query, matches))
+}
+
+// random returns a random acronym, never from a cache
+func (s *Server) random(w http.ResponseWriter, r *http.Request) {
+	a, err := s.repo.Random()
+	if errors.Is(err, acronym.ErrEmptyRepository) {
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeBody(w, http.StatusOK, output.NewResult("", acronym.Match{Acronym: *a, Type: acronym.MatchRandom, Score: 1}))
+}
+
+// page is one page of /all
+type page struct {
+	Page     int             `json:"page"`
+	PerPage  int             `json:"per_page"`
+	Total    int             `json:"total"`
+	Acronyms []output.Result `json:"acronyms"`
+}
+
+// all lists every acronym a page at a time, with ?page= counting from 1 and
+// ?per_page= up to maxPerPage
+func (s *Server) all(w http.ResponseWriter, r *http.Request) {
+	number, ok := intParam(w, r, "page", 1)
+	if !ok {
+		return
+	}
+	perPage, ok := intParam(w, r, "per_page", defaultPerPage)
+	if !ok {
+		return
+	}
+	perPage = min(perPage, maxPerPage)
+
+	all, err := s.repo.All()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	start := len(all)
+	if number-1 <= len(all)/perPage {
+		start = min((number-1)*perPage, len(all))
+	}
+	end := min(start+perPage, len(all))
+	acronyms := make([]output.Result, 0, end-start)
+	for _, a := range all[start:end] {
+		acronyms = append(acronyms, output.NewResult("", acronym.Match{Acronym: a, Type: acronym.MatchExact, Score: 1}))
+	}
+	writeJSON(w, r, http.StatusOK, page{Page: number, PerPage: perPage, Total: len(all), Acronyms: acronyms})
+}
+
+// expandRequest is the JSON body /expand accepts
+type expandRequest struct {
+	Text  string `json:"text"`
+	Style string `json:"style"`
+}
+
+// expandResponse is the annotated text
+type expandResponse struct {
+	Text string `json:"text"`
+}
+
+// expand annotates the acronyms in the posted text. The body is either plain
+// text, or JSON with text and an optional style of inline, footnote or
+// glossary; ?style= works for either.
+func (s *Server) expand(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxExpandBody))
+	if err != nil {
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("text is larger than %d bytes", maxExpandBody))
+		return
+	}
+
+	req := expandRequest{Text: string(body), Style: r.URL.Query().Get("style")}
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "application/json" {
+		req = expandRequest{Style: req.Style}
+		if err := json.Unmarshal(body, &req); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid JSON: %v", err))
+			return
+		}
+	}
+
+	expanded, err := expand.Expand(req.Text, s.repo, expand.Options{Style: expand.Style(req.Style)})
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeBody(w, http.StatusOK, expandResponse{Text: expanded})
+}
+
+// cors lets browsers on the allowed origins call the API and answers their
+// preflight requests
+func (s *Server) cors(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Origin")
+		allowed := s.allowOrigin(origin)
+		if allowed != "" {
+			w.Header().Set("Access-Control-Allow-Origin", allowed)
+			w.Header().Set("Access-Control-Expose-Headers", "ETag")
+		}
+
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			if allowed != "" {
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, If-None-Match")
+				w.Header().Set("Access-Control-Max-Age", "600")
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allowOrigin returns the Access-Control-Allow-Origin value for origin, or ""
+// when it isn't allowed
+func (s *Server) allowOrigin(origin string) string {
+	for _, allowed := range s.opts.AllowOrigins {
+		if allowed == "*" {
+			return "*"
+		}
+		if strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return origin
+		}
+	}
+	return ""
+}
+
+// errorResponse is the body of every error
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeBody(w, status, errorResponse{Error: message})
+}
+
+// writeJSON writes v with an ETag derived from its encoding, answering 304
+// Not Modified when the client already has it. Lookups only change when the
+// dictionaries do, so clients can cache them safely. Only 200 OK responses
+// are cached; anything else is written as is.
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, v any) {
+	if status != http.StatusOK {
+		writeBody(w, status, v)
+		return
+	}
+	body, err := encode(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	if matchesETag(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// writeBody writes v without caching headers
+func writeBody(w http.ResponseWriter, status int, v any) {
+	body, err := encode(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+func encode(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("failed to encode response: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// matchesETag reports whether an If-None-Match header lists etag
+func matchesETag(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// requireQuery returns ?q=, answering 400 Bad Request when it is missing
+func requireQuery(w http.ResponseWriter, r *http.Request) (string, bool) {
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		writeError(w, http.StatusBadRequest, "missing query parameter q")
+		return "", false
+	}
+	return query, true
+}
+
+// intParam returns a positive integer query parameter, or fallback when it
+// is absent, answering 400 Bad Request when it is invalid
+func intParam(w http.ResponseWriter, r *http.Request, name string, fallback int) (int, bool) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return fallback, true
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("%s should be a positive integer, not %q", name, value))
+		return 0, false
+	}
+	return n, true
+}
+
+// results converts matches to results for query
+func results(query string, matches []acronym.Match) []output.Result {
+	converted := make([]output.Result, len(matches))
+	for i, m := range matches {
+		converted[i] = output.NewResult(query, m)
+	}
+	return converted
+}
@@ -0,0 +1,302 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/output"
+)
+
+// testEntries is the dictionary every test serves
+var testEntries = []acronym.Entry{
+	{Acronym: "ABG", FullForm: "Arterial Blood Gas", Definition: "Blood test from an artery"},
+	{Acronym: "BP", FullForm: "Blood Pressure"},
+	{Acronym: "CXR", FullForm: "Chest X-Ray"},
+	{Acronym: "ECG", FullForm: "Electrocardiogram"},
+	{Acronym: "MI", FullForm: "Myocardial Infarction", Specialty: "cardiology"},
+	{Acronym: "MI", FullForm: "Mitral Insufficiency", Specialty: "cardiology"},
+}
+
+// newTestServer serves testEntries, allowing requests from origins
+func newTestServer(t *testing.T, origins ...string) *httptest.Server {
+	t.Helper()
+	repo, err := acronym.NewEntryRepository("test", testEntries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(New(repo, Options{AllowOrigins: origins}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// do sends req and returns the response with its body read
+func do(t *testing.T, req *http.Request) (*http.Response, []byte) {
+	t.Helper()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, body
+}
+
+// get fetches path, decoding a JSON body into v unless it is nil
+func get(t *testing.T, ts *httptest.Server, path string, wantStatus int, v any) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, ts.URL+path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, body := do(t, req)
+	if resp.StatusCode != wantStatus {
+		t.Fatalf("GET %s: status %d, want %d: %s", path, resp.StatusCode, wantStatus, body)
+	}
+	if v != nil {
+		if err := json.Unmarshal(body, v); err != nil {
+			t.Fatalf("GET %s: %v: %s", path, err, body)
+		}
+	}
+	return resp
+}
+
+func TestLookup(t *testing.T) {
+	ts := newTestServer(t)
+
+	var result output.Result
+	get(t, ts, "/lookup/abg", http.StatusOK, &result)
+	if result.Acronym != "ABG" || len(result.Senses) != 1 || result.Senses[0].FullForm != "Arterial Blood Gas" {
+		t.Errorf("lookup ABG = %+v", result)
+	}
+
+	get(t, ts, "/lookup/MI?context=mitral+valve+regurgitation", http.StatusOK, &result)
+	if len(result.Senses) != 2 || result.Senses[0].FullForm != "Mitral Insufficiency" {
+		t.Errorf("lookup MI with context = %+v, want Mitral Insufficiency first", result.Senses)
+	}
+
+	var missing notFoundResponse
+	get(t, ts, "/lookup/ABX", http.StatusNotFound, &missing)
+	if missing.Error == "" || len(missing.Suggestions) == 0 || missing.Suggestions[0].Acronym != "ABG" {
+		t.Errorf("lookup ABX = %+v, want ABG suggested", missing)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	ts := newTestServer(t)
+
+	var results []output.Result
+	get(t, ts, "/search?q=blood", http.StatusOK, &results)
+	if got := resultKeys(results); len(got) != 2 || !slices.Contains(got, "ABG") || !slices.Contains(got, "BP") {
+		t.Errorf("search blood = %v, want ABG and BP", got)
+	}
+
+	get(t, ts, "/search?q=bloo&prefix=true&limit=1", http.StatusOK, &results)
+	if len(results) != 1 {
+		t.Errorf("search with limit 1 returned %d results", len(results))
+	}
+
+	get(t, ts, "/search?q=blod&fuzzy=true", http.StatusOK, &results)
+	if len(results) == 0 {
+		t.Error("fuzzy search for blod found nothing")
+	}
+
+	get(t, ts, "/search", http.StatusBadRequest, nil)
+	get(t, ts, "/search?q=blood&limit=0", http.StatusBadRequest, nil)
+}
+
+func TestFuzzy(t *testing.T) {
+	ts := newTestServer(t)
+
+	var results []output.Result
+	get(t, ts, "/fuzzy?q=EKG", http.StatusOK, &results)
+	if len(results) == 0 || results[0].Acronym != "ECG" {
+		t.Errorf("fuzzy EKG = %v, want ECG first", resultKeys(results))
+	}
+}
+
+func TestAllPages(t *testing.T) {
+	ts := newTestServer(t)
+
+	tests := []struct {
+		query string
+		page  int
+		keys  []string
+	}{
+		{"", 1, []string{"ABG", "BP", "CXR", "ECG", "MI"}},
+		{"?per_page=2", 1, []string{"ABG", "BP"}},
+		{"?per_page=2&page=2", 2, []string{"CXR", "ECG"}},
+		{"?per_page=2&page=3", 3, []string{"MI"}},
+		{"?per_page=2&page=4", 4, []string{}},
+		{"?per_page=2&page=100", 100, []string{}},
+	}
+	for _, tt := range tests {
+		var p page
+		get(t, ts, "/all"+tt.query, http.StatusOK, &p)
+		if p.Page != tt.page || p.Total != 5 || strings.Join(resultKeys(p.Acronyms), ",") != strings.Join(tt.keys, ",") {
+			t.Errorf("/all%s = page %d of %d with %v, want page %d with %v", tt.query, p.Page, p.Total, resultKeys(p.Acronyms), tt.page, tt.keys)
+		}
+	}
+
+	get(t, ts, "/all?page=0", http.StatusBadRequest, nil)
+	get(t, ts, "/all?per_page=x", http.StatusBadRequest, nil)
+}
+
+func TestETag(t *testing.T) {
+	ts := newTestServer(t)
+
+	first := get(t, ts, "/lookup/ABG", http.StatusOK, nil)
+	etag := first.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("lookup has no ETag")
+	}
+
+	tests := []struct {
+		path, ifNoneMatch string
+		want              int
+	}{
+		{"/lookup/ABG", etag, http.StatusNotModified},
+		{"/lookup/ABG", "W/" + etag, http.StatusNotModified},
+		{"/lookup/ABG", `"other", ` + etag, http.StatusNotModified},
+		{"/lookup/ABG", `"other"`, http.StatusOK},
+		{"/lookup/BP", etag, http.StatusOK},
+		// Errors are never cached, however the client asks
+		{"/lookup/ABX", "*", http.StatusNotFound},
+		{"/search", "*", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+tt.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("If-None-Match", tt.ifNoneMatch)
+		resp, body := do(t, req)
+		if resp.StatusCode != tt.want {
+			t.Errorf("GET %s with If-None-Match %s: status %d, want %d", tt.path, tt.ifNoneMatch, resp.StatusCode, tt.want)
+		}
+		if resp.StatusCode == http.StatusNotModified && len(body) > 0 {
+			t.Errorf("GET %s: 304 with a body", tt.path)
+		}
+		if resp.StatusCode >= 400 && resp.Header.Get("ETag") != "" {
+			t.Errorf("GET %s: %d with an ETag", tt.path, resp.StatusCode)
+		}
+	}
+
+	random := get(t, ts, "/random", http.StatusOK, nil)
+	if random.Header.Get("ETag") != "" || random.Header.Get("Cache-Control") != "no-store" {
+		t.Errorf("random is cacheable: %v", random.Header)
+	}
+}
+
+func TestCORS(t *testing.T) {
+	tests := []struct {
+		name       string
+		origins    []string
+		origin     string
+		wantOrigin string
+	}{
+		{"any", []string{"*"}, "https://example.com", "*"},
+		{"listed", []string{"https://example.com/"}, "https://example.com", "https://example.com"},
+		{"unlisted", []string{"https://example.com"}, "https://other.example", ""},
+		{"none", nil, "https://example.com", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newTestServer(t, tt.origins...)
+
+			req, err := http.NewRequest(http.MethodOptions, ts.URL+"/expand", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Origin", tt.origin)
+			req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			resp, _ := do(t, req)
+			if resp.StatusCode != http.StatusNoContent {
+				t.Errorf("preflight status %d, want %d", resp.StatusCode, http.StatusNoContent)
+			}
+			if got := resp.Header.Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Errorf("preflight Access-Control-Allow-Origin = %q, want %q", got, tt.wantOrigin)
+			}
+			allowed := resp.Header.Get("Access-Control-Allow-Methods") != ""
+			if allowed != (tt.wantOrigin != "") {
+				t.Errorf("preflight Access-Control-Allow-Methods = %q", resp.Header.Get("Access-Control-Allow-Methods"))
+			}
+
+			req, err = http.NewRequest(http.MethodGet, ts.URL+"/lookup/ABG", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Origin", tt.origin)
+			resp, _ = do(t, req)
+			if got := resp.Header.Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Errorf("GET Access-Control-Allow-Origin = %q, want %q", got, tt.wantOrigin)
+			}
+			if resp.Header.Get("Vary") != "Origin" {
+				t.Errorf("GET Vary = %q, want Origin", resp.Header.Get("Vary"))
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	ts := newTestServer(t)
+
+	tests := []struct {
+		name        string
+		query       string
+		contentType string
+		body        string
+		status      int
+		want        string
+	}{
+		{"plain", "", "text/plain", "Check the ABG.", http.StatusOK, "Check the ABG (Arterial Blood Gas)."},
+		{"json", "", "application/json; charset=utf-8", `{"text": "BP and CXR"}`, http.StatusOK, "BP (Blood Pressure) and CXR (Chest X-Ray)"},
+		{"json style", "", "application/json", `{"text": "ABG", "style": "footnote"}`, http.StatusOK, "[^"},
+		{"query style", "?style=glossary", "text/plain", "ABG", http.StatusOK, "Arterial Blood Gas"},
+		{"bad style", "?style=sideways", "text/plain", "ABG", http.StatusBadRequest, ""},
+		{"bad json", "", "application/json", `{"text": `, http.StatusBadRequest, ""},
+		{"too large", "", "text/plain", strings.Repeat("x", maxExpandBody+1), http.StatusRequestEntityTooLarge, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, ts.URL+"/expand"+tt.query, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", tt.contentType)
+			resp, body := do(t, req)
+			if resp.StatusCode != tt.status {
+				t.Fatalf("status %d, want %d: %s", resp.StatusCode, tt.status, body)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			var got expandResponse
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(got.Text, tt.want) {
+				t.Errorf("expanded %q to %q, want it to contain %q", tt.body, got.Text, tt.want)
+			}
+		})
+	}
+
+	get(t, ts, "/expand", http.StatusMethodNotAllowed, nil)
+}
+
+func resultKeys(results []output.Result) []string {
+	keys := make([]string, len(results))
+	for i, r := range results {
+		keys[i] = r.Acronym
+	}
+	return keys
+}
@@ -0,0 +1,60 @@
+// Package theme defines the color palettes the Terminal App can draw with
+package theme
+
+import "github.com/charmbracelet/lipgloss"
+
+// Theme is a palette. Adaptive colors pick a shade for light or dark
+// terminals.
+type Theme struct {
+	// Primary is for text, Secondary for borders, hints and definitions
+	Primary   lipgloss.TerminalColor
+	Secondary lipgloss.TerminalColor
+	// Accent marks the title, the selection and the cursor
+	Accent lipgloss.TerminalColor
+	// Error is for errors and do-not-use warnings
+	Error lipgloss.TerminalColor
+	// Success and Info are the backgrounds of update notices
+	Success lipgloss.TerminalColor
+	Info    lipgloss.TerminalColor
+}
+
+// Default is the name of the theme used unless another is configured
+const Default = "default"
+
+// Names lists every theme, default first
+var Names = []string{Default, "high-contrast", "mono"}
+
+var themes = map[string]Theme{
+	Default: {
+		Primary:   lipgloss.AdaptiveColor{Light: "0", Dark: "15"},    // Black in light mode, White in dark mode
+		Secondary: lipgloss.AdaptiveColor{Light: "8", Dark: "7"},     // Gray
+		Accent:    lipgloss.AdaptiveColor{Light: "202", Dark: "202"}, // Orange (ANSI 256 color)
+		Error:     lipgloss.AdaptiveColor{Light: "1", Dark: "9"},
+		Success:   lipgloss.AdaptiveColor{Light: "2", Dark: "10"},
+		Info:      lipgloss.AdaptiveColor{Light: "4", Dark: "12"},
+	},
+	// high-contrast drops the grays and uses bright accents
+	"high-contrast": {
+		Primary:   lipgloss.AdaptiveColor{Light: "0", Dark: "15"},
+		Secondary: lipgloss.AdaptiveColor{Light: "0", Dark: "15"},
+		Accent:    lipgloss.AdaptiveColor{Light: "4", Dark: "11"},
+		Error:     lipgloss.AdaptiveColor{Light: "1", Dark: "9"},
+		Success:   lipgloss.AdaptiveColor{Light: "2", Dark: "10"},
+		Info:      lipgloss.AdaptiveColor{Light: "4", Dark: "12"},
+	},
+	// mono leaves every color to the terminal, keeping bold and italics
+	"mono": {
+		Primary:   lipgloss.NoColor{},
+		Secondary: lipgloss.NoColor{},
+		Accent:    lipgloss.NoColor{},
+		Error:     lipgloss.NoColor{},
+		Success:   lipgloss.NoColor{},
+		Info:      lipgloss.NoColor{},
+	},
+}
+
+// Lookup returns the theme called name
+func Lookup(name string) (Theme, bool) {
+	t, ok := themes[name]
+	return t, ok
+}
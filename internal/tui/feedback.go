@@ -231,7 +231,7 @@ func (f *FeedbackForm) View() string {
 	
 	// Show question counter
 	counterStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.AdaptiveColor{Light: "8", Dark: "7"})
+		Foreground(secondaryColor)
 	
 	s.WriteString(counterStyle.Render(fmt.Sprintf("Question %d of %d", f.currentField+1, len(f.fields))))
 	s.WriteString("\n\n")
@@ -250,11 +250,11 @@ func (f *FeedbackForm) View() string {
 		field := f.fields[i]
 		
 		// Field label
-		labelStyle := lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "0", Dark: "15"})
+		labelStyle := lipgloss.NewStyle().Foreground(primaryColor)
 		if i == f.currentField {
-			labelStyle = labelStyle.Bold(true).Foreground(lipgloss.AdaptiveColor{Light: "202", Dark: "202"})
+			labelStyle = labelStyle.Bold(true).Foreground(accentColor)
 		} else {
-			labelStyle = labelStyle.Foreground(lipgloss.AdaptiveColor{Light: "8", Dark: "8"})
+			labelStyle = labelStyle.Foreground(secondaryColor)
 		}
 		
 		s.WriteString(labelStyle.Render(fmt.Sprintf("%d. %s", i+1, field.label)))
@@ -264,11 +264,11 @@ func (f *FeedbackForm) View() string {
 		if i == f.currentField {
 			if field.isSelect {
 				for _, opt := range field.options {
-					optionStyle := lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "8", Dark: "7"})
+					optionStyle := lipgloss.NewStyle().Foreground(secondaryColor)
 					prefix := "   ○ "
 					
 					if opt == field.value {
-						optionStyle = optionStyle.Foreground(lipgloss.AdaptiveColor{Light: "202", Dark: "202"}).Bold(true)
+						optionStyle = optionStyle.Foreground(accentColor).Bold(true)
 						prefix = " ▸ ● "
 					}
 					
@@ -283,7 +283,7 @@ func (f *FeedbackForm) View() string {
 			}
 		} else {
 			// Show selected value for non-current fields
-			valueStyle := lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "8", Dark: "8"})
+			valueStyle := lipgloss.NewStyle().Foreground(secondaryColor)
 			if field.isSelect {
 				s.WriteString(valueStyle.Render("   → " + field.value))
 			} else if field.value != "" {
@@ -295,7 +295,7 @@ func (f *FeedbackForm) View() string {
 	}
 	
 	// Instructions
-	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "8", Dark: "8"}).MarginTop(1)
+	helpStyle := lipgloss.NewStyle().Foreground(secondaryColor).MarginTop(1)
 	help := "↑/↓ = navigate • ←/→ = select • enter = submit • esc = back"
 	s.WriteString(helpStyle.Render(help))
 	
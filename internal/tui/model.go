@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"os"
 	"runtime"
+	"sort"
 	"time"
 
 	"github.com/anthonylangham/tmdr/internal/acronym"
@@ -33,6 +34,13 @@ type Model struct {
 	cursor       int
 	searchInput  textinput.Model
 	selected     *acronym.Acronym
+	// reverse searches by meaning, matching the query against full forms
+	reverse      bool
+	scores       map[string]float64
+	// categories are the specialties browse can be narrowed to, after ""
+	// for all of them
+	categories   []string
+	category     int
 	width        int
 	height       int
 	err          error
@@ -51,6 +59,7 @@ type Model struct {
 	email             string
 	
 	// Update state
+	updatePolicy      update.Policy
 	updateInfo        update.UpdateInfo
 	updateDownloading bool
 	updateProgress    float64
@@ -58,28 +67,40 @@ type Model struct {
 	updateReady       bool
 }
 
-func NewModel(repo acronym.Repository) Model {
+// Options tunes the Terminal App
+type Options struct {
+	// UpdatePolicy says whether to check for and install releases at
+	// startup; the default is update.PolicyAuto
+	UpdatePolicy update.Policy
+}
+
+func NewModel(repo acronym.Repository, opts Options) Model {
 	acronyms, _ := repo.All()
 	
 	// Initialize text input with orange cursor
 	ti := textinput.New()
-	ti.Placeholder = "Type to search..."
+	ti.Placeholder = searchPlaceholder(false)
 	ti.Focus()
 	ti.CharLimit = 100
 	ti.Width = 50
 	
-	// Set cursor to orange using adaptive colors for compatibility
-	ti.TextStyle = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "0", Dark: "15"})
-	ti.Cursor.Style = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "202", Dark: "202"})
-	ti.Cursor.TextStyle = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "0", Dark: "15"})
+	// Color the cursor with the theme's accent
+	ti.TextStyle = lipgloss.NewStyle().Foreground(primaryColor)
+	ti.Cursor.Style = lipgloss.NewStyle().Foreground(accentColor)
+	ti.Cursor.TextStyle = lipgloss.NewStyle().Foreground(primaryColor)
 	
 	m := Model{
 		state:         StateHome,
 		repo:          repo,
 		acronyms:      acronyms,
 		filtered:      acronyms,
+		categories:    categoriesOf(acronyms),
 		searchInput:   ti,
 		feedbackForm:  NewFeedbackForm(),
+		updatePolicy:  opts.UpdatePolicy,
+	}
+	if m.updatePolicy == "" {
+		m.updatePolicy = update.PolicyAuto
 	}
 	
 	return m
@@ -87,11 +108,12 @@ func NewModel(repo acronym.Repository) Model {
 
 func (m Model) Init() tea.Cmd {
 	// Request window size, start textinput blinking, and check for updates
-	return tea.Batch(
-		tea.WindowSize(),
-		textinput.Blink,
-		m.checkForUpdate(),
-	)
+	// unless they are turned off
+	cmds := []tea.Cmd{tea.WindowSize(), textinput.Blink}
+	if m.updatePolicy != update.PolicyOff {
+		cmds = append(cmds, m.checkForUpdate())
+	}
+	return tea.Batch(cmds...)
 }
 
 // Custom messages for update process
@@ -144,7 +166,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case updateAvailableMsg:
 		m.updateInfo = update.UpdateInfo(msg)
-		if m.updateInfo.Available && !m.updateDownloading && !m.updateReady {
+		if m.updatePolicy == update.PolicyAuto && m.updateInfo.Available && !m.updateDownloading && !m.updateReady {
 			// Start downloading automatically
 			m.updateDownloading = true
 			return m, m.downloadUpdate()
@@ -296,6 +318,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.state = StateBrowse
 				}
 				return m, nil
+			case "tab":
+				m.reverse = !m.reverse
+				m.searchInput.Placeholder = searchPlaceholder(m.reverse)
+				m.filterAcronyms()
+				return m, nil
 			case "ctrl+c":
 				return m, tea.Quit
 			case "q":
@@ -367,11 +394,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		case "b":
 			m.state = StateBrowse
-			m.cursor = 0
-			m.filtered = m.acronyms
-			if len(m.filtered) > 0 {
-				m.selected = &m.filtered[0]
-			}
+			m.applyCategory()
 			return m, nil
 
 		case "f":
@@ -400,6 +423,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						m.selected = &m.filtered[m.cursor]
 					}
 				}
+			case "c":
+				m.category = (m.category + 1) % len(m.categories)
+				m.applyCategory()
+			case "C":
+				m.category = (m.category + len(m.categories) - 1) % len(m.categories)
+				m.applyCategory()
 			}
 		}
 	}
@@ -407,19 +436,95 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// applyCategory lists the acronyms in the selected category for browsing,
+// keeping only their senses in that specialty
+func (m *Model) applyCategory() {
+	m.filtered = m.acronyms
+	if specialty := m.categories[m.category]; specialty != "" {
+		m.filtered = []acronym.Acronym{}
+		for _, a := range m.acronyms {
+			if narrowed, ok := a.InSpecialty(specialty); ok {
+				m.filtered = append(m.filtered, narrowed)
+			}
+		}
+	}
+	m.cursor = 0
+	m.selected = nil
+	if len(m.filtered) > 0 {
+		m.selected = &m.filtered[0]
+	}
+}
+
+// categoriesOf returns "" for all acronyms followed by each specialty used,
+// taxonomy specialties first in their display order
+func categoriesOf(acronyms []acronym.Acronym) []string {
+	used := make(map[string]bool)
+	for _, a := range acronyms {
+		for _, specialty := range a.Specialties() {
+			used[specialty] = true
+		}
+	}
+
+	categories := []string{""}
+	for _, specialty := range acronym.SpecialtyNames() {
+		if used[specialty] {
+			categories = append(categories, specialty)
+			delete(used, specialty)
+		}
+	}
+	var custom []string
+	for specialty := range used {
+		custom = append(custom, specialty)
+	}
+	sort.Strings(custom)
+	return append(categories, custom...)
+}
+
+// categoryLabel names a browse category for display
+func categoryLabel(specialty string) string {
+	if specialty == "" {
+		return "All"
+	}
+	return acronym.SpecialtyLabel(specialty)
+}
+
 func (m *Model) filterAcronyms() {
 	query := m.searchInput.Value()
+	m.scores = nil
 	if query == "" {
 		m.filtered = m.acronyms
 		m.cursor = 0
 		return
 	}
+	if m.reverse {
+		m.filterByMeaning(query)
+		return
+	}
 
+	// Acronyms containing the query come first, then ranked full-text
+	// matches, then any full form that merely contains the query
 	filtered := []acronym.Acronym{}
+	seen := make(map[string]bool)
+	add := func(a acronym.Acronym) {
+		if !seen[a.Acronym] {
+			seen[a.Acronym] = true
+			filtered = append(filtered, a)
+		}
+	}
 
 	for _, a := range m.acronyms {
-		if contains(a.Acronym, query) || contains(a.FullForm, query) {
-			filtered = append(filtered, a)
+		if contains(a.Acronym, query) {
+			add(a)
+		}
+	}
+	if matches, err := m.repo.Search(query, acronym.SearchOptions{Prefix: true}); err == nil {
+		for _, match := range matches {
+			add(match.Acronym)
+		}
+	}
+	for _, a := range m.acronyms {
+		if sensesContain(a.Senses, query) {
+			add(a)
 		}
 	}
 
@@ -432,33 +537,51 @@ func (m *Model) filterAcronyms() {
 	}
 }
 
-func contains(s, substr string) bool {
-	if len(substr) > len(s) {
-		return false
+// filterByMeaning ranks acronyms by how well their full forms match the
+// query, tolerating word order and typos
+func (m *Model) filterByMeaning(query string) {
+	matches, _ := m.repo.Search(query, acronym.SearchOptions{
+		Prefix: true,
+		Fuzzy:  true,
+		Fields: []acronym.SearchField{acronym.FieldFullForm, acronym.FieldSynonyms},
+	})
+
+	m.filtered = make([]acronym.Acronym, len(matches))
+	m.scores = make(map[string]float64, len(matches))
+	for i, match := range matches {
+		m.filtered[i] = match.Acronym
+		m.scores[match.Acronym.Acronym] = match.Score
 	}
-	for i := 0; i <= len(s)-len(substr); i++ {
-		match := true
-		for j := 0; j < len(substr); j++ {
-			if toUpper(s[i+j]) != toUpper(substr[j]) {
-				match = false
-				break
-			}
-		}
-		if match {
+	m.cursor = 0
+	m.selected = nil
+	if len(m.filtered) > 0 {
+		m.selected = &m.filtered[0]
+	}
+}
+
+func searchPlaceholder(reverse bool) string {
+	if reverse {
+		return "Type a meaning, e.g. end stage renal disease..."
+	}
+	return "Type to search..."
+}
+
+// sensesContain reports whether any sense's full form matches the query
+func sensesContain(senses []acronym.Sense, query string) bool {
+	for _, sense := range senses {
+		if contains(sense.FullForm, query) {
 			return true
 		}
 	}
 	return false
 }
 
-func toUpper(c byte) byte {
-	if c >= 'a' && c <= 'z' {
-		return c - 32
-	}
-	return c
+// contains reports whether substr is within s, ignoring case, accents and
+// full-width forms
+func contains(s, substr string) bool {
+	return acronym.ContainsFold(s, substr)
 }
 
-
 func (m *Model) formatFeedbackEmail() string {
 	usefulStr := "No"
 	if m.useful {
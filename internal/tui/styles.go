@@ -1,94 +1,147 @@
 package tui
 
 import (
+	"github.com/anthonylangham/tmdr/internal/theme"
 	"github.com/charmbracelet/lipgloss"
 )
 
+// Colors, set by UseTheme
 var (
-	// Colors - using adaptive colors for better terminal compatibility
-	primaryColor   = lipgloss.AdaptiveColor{Light: "0", Dark: "15"}    // Black in light mode, White in dark mode
-	secondaryColor = lipgloss.AdaptiveColor{Light: "8", Dark: "7"}     // Gray
-	accentColor    = lipgloss.AdaptiveColor{Light: "202", Dark: "202"} // Orange (ANSI 256 color)
-	bgColor        = lipgloss.NoColor{}                                // No explicit background
+	primaryColor   lipgloss.TerminalColor
+	secondaryColor lipgloss.TerminalColor
+	accentColor    lipgloss.TerminalColor
+	errorColor     lipgloss.TerminalColor
+	successColor   lipgloss.TerminalColor
+	infoColor      lipgloss.TerminalColor
+	bgColor        = lipgloss.NoColor{} // No explicit background
+)
+
+// Styles, rebuilt from the colors by UseTheme
+var (
+	baseStyle            lipgloss.Style
+	fullScreenStyle      lipgloss.Style
+	navBarStyle          lipgloss.Style
+	navItemStyle         lipgloss.Style
+	navSeparatorStyle    lipgloss.Style
+	contentStyle         lipgloss.Style
+	titleStyle           lipgloss.Style
+	subtitleStyle        lipgloss.Style
+	listItemStyle        lipgloss.Style
+	selectedItemStyle    lipgloss.Style
+	acronymStyle         lipgloss.Style
+	fullFormStyle        lipgloss.Style
+	definitionStyle      lipgloss.Style
+	senseDefinitionStyle lipgloss.Style
+	searchPromptStyle    lipgloss.Style
+	searchInputStyle     lipgloss.Style
+	containerStyle       lipgloss.Style
+	helpStyle            lipgloss.Style
+	errorStyle           lipgloss.Style
+	warningStyle         lipgloss.Style
+)
+
+func init() {
+	t, _ := theme.Lookup(theme.Default)
+	UseTheme(t)
+}
+
+// UseTheme sets the colors the Terminal App draws with. Call it before
+// NewModel.
+func UseTheme(t theme.Theme) {
+	primaryColor = t.Primary
+	secondaryColor = t.Secondary
+	accentColor = t.Accent
+	errorColor = t.Error
+	successColor = t.Success
+	infoColor = t.Info
 
 	// Base styles
 	baseStyle = lipgloss.NewStyle().
-			Foreground(primaryColor)
+		Foreground(primaryColor)
 
 	// Full screen style
 	fullScreenStyle = lipgloss.NewStyle().
-			Foreground(primaryColor)
+		Foreground(primaryColor)
 
 	// Navigation bar styles
 	navBarStyle = lipgloss.NewStyle().
-			BorderStyle(lipgloss.NormalBorder()).
-			BorderBottom(true).
-			BorderForeground(secondaryColor).
-			Padding(0, 1)
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderBottom(true).
+		BorderForeground(secondaryColor).
+		Padding(0, 1)
 
 	navItemStyle = lipgloss.NewStyle().
-			Padding(0, 1).
-			Foreground(primaryColor)
+		Padding(0, 1).
+		Foreground(primaryColor)
 
 	navSeparatorStyle = lipgloss.NewStyle().
-				Foreground(secondaryColor).
-				Padding(0, 1)
+		Foreground(secondaryColor).
+		Padding(0, 1)
 
 	// Content area styles
 	contentStyle = lipgloss.NewStyle().
-			Padding(1, 2)
+		Padding(1, 2)
 
 	// Title styles
 	titleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(accentColor)  // Orange color for title
+		Bold(true).
+		Foreground(accentColor)
 
 	subtitleStyle = lipgloss.NewStyle().
-			Foreground(secondaryColor)
+		Foreground(secondaryColor)
 
 	// List styles
 	listItemStyle = lipgloss.NewStyle().
-			PaddingLeft(2)
+		PaddingLeft(2)
 
 	selectedItemStyle = lipgloss.NewStyle().
-				PaddingLeft(0).
-				Foreground(accentColor).
-				Bold(true)
+		PaddingLeft(0).
+		Foreground(accentColor).
+		Bold(true)
 
 	// Acronym display styles
 	acronymStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(primaryColor)
+		Bold(true).
+		Foreground(primaryColor)
 
 	fullFormStyle = lipgloss.NewStyle().
-			Foreground(primaryColor)
+		Foreground(primaryColor)
 
 	definitionStyle = lipgloss.NewStyle().
-			Foreground(secondaryColor).
-			PaddingTop(1)
+		Foreground(secondaryColor).
+		PaddingTop(1)
+
+	senseDefinitionStyle = lipgloss.NewStyle().
+		Foreground(secondaryColor).
+		PaddingLeft(3)
 
 	// Search styles
 	searchPromptStyle = lipgloss.NewStyle().
-				Foreground(secondaryColor)
+		Foreground(secondaryColor)
 
 	searchInputStyle = lipgloss.NewStyle().
-				Foreground(primaryColor).
-				Bold(true)
+		Foreground(primaryColor).
+		Bold(true)
 
 	// Border styles for main container
 	containerStyle = lipgloss.NewStyle().
-			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(secondaryColor)
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(secondaryColor)
 
 	// Help text style
 	helpStyle = lipgloss.NewStyle().
-			Foreground(secondaryColor).
-			Italic(true)
+		Foreground(secondaryColor).
+		Italic(true)
 
 	// Error style
 	errorStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.AdaptiveColor{Light: "1", Dark: "9"})
-)
+		Foreground(errorColor)
+
+	// Do-not-use warning style
+	warningStyle = lipgloss.NewStyle().
+		Foreground(errorColor).
+		Bold(true)
+}
 
 func renderNavBar() string {
 	// Special style for "tmdr" with orange and bold
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/update"
 	"github.com/anthonylangham/tmdr/internal/version"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -17,28 +19,32 @@ func (m Model) View() string {
 	var updateNotification string
 	if m.updateReady {
 		updateNotification = lipgloss.NewStyle().
-			Background(lipgloss.AdaptiveColor{Light: "2", Dark: "10"}).
-			Foreground(lipgloss.AdaptiveColor{Light: "0", Dark: "15"}).
+			Background(successColor).
+			Foreground(primaryColor).
 			Padding(0, 1).
 			Width(m.width).
 			Align(lipgloss.Center).
 			Render(fmt.Sprintf("✨ tmdr v%s downloaded! Restart to apply update", m.updateInfo.Version))
 	} else if m.updateDownloading {
 		updateNotification = lipgloss.NewStyle().
-			Background(lipgloss.AdaptiveColor{Light: "202", Dark: "202"}).
-			Foreground(lipgloss.AdaptiveColor{Light: "0", Dark: "15"}).
+			Background(accentColor).
+			Foreground(primaryColor).
 			Padding(0, 1).
 			Width(m.width).
 			Align(lipgloss.Center).
 			Render(fmt.Sprintf("⬇️ Downloading tmdr v%s...", m.updateInfo.Version))
 	} else if m.updateInfo.Available && m.updateError == nil {
+		notice := fmt.Sprintf("🆕 tmdr v%s is available!", m.updateInfo.Version)
+		if m.updatePolicy == update.PolicyNotify {
+			notice += " Run tmdr update to install it"
+		}
 		updateNotification = lipgloss.NewStyle().
-			Background(lipgloss.AdaptiveColor{Light: "4", Dark: "12"}).
-			Foreground(lipgloss.AdaptiveColor{Light: "0", Dark: "15"}).
+			Background(infoColor).
+			Foreground(primaryColor).
 			Padding(0, 1).
 			Width(m.width).
 			Align(lipgloss.Center).
-			Render(fmt.Sprintf("🆕 tmdr v%s is available!", m.updateInfo.Version))
+			Render(notice)
 	}
 
 	// Check minimum terminal size
@@ -61,7 +67,7 @@ func (m Model) View() string {
 		Width(m.width - 2).
 		BorderStyle(lipgloss.NormalBorder()).
 		BorderBottom(true).
-		BorderForeground(lipgloss.AdaptiveColor{Light: "8", Dark: "7"}).
+		BorderForeground(secondaryColor).
 		Padding(0, 1).
 		Render(navContent)
 
@@ -190,7 +196,7 @@ func (m Model) viewBrowse() string {
 
 	for i := start; i < end; i++ {
 		item := m.filtered[i]
-		line := fmt.Sprintf("%-6s %s", item.Acronym, item.FullForm)
+		line := formatListLine(item)
 		
 		if i == m.cursor {
 			listBuilder.WriteString(selectedItemStyle.Render("> " + line))
@@ -205,23 +211,16 @@ func (m Model) viewBrowse() string {
 	// Display selected acronym details
 	var details string
 	if m.selected != nil {
-		acronymLine := fmt.Sprintf("%s → %s", 
-			acronymStyle.Render(m.selected.Acronym),
-			fullFormStyle.Render(m.selected.FullForm))
-		
-		definition := definitionStyle.Render(m.selected.Definition)
-		
-		details = lipgloss.JoinVertical(
-			lipgloss.Left,
-			strings.Repeat("─", 60),
-			acronymLine,
-			definition,
-		)
+		details = renderDetails(m.selected)
 	}
 
+	category := searchPromptStyle.Render("Category: ") +
+		fmt.Sprintf("%s (%d)", categoryLabel(m.categories[m.category]), len(m.filtered)) +
+		helpStyle.Render("  c/C: change category")
+
 	content := lipgloss.JoinVertical(
 		lipgloss.Left,
-		"",
+		category,
 		listBuilder.String(),
 		"",
 		details,
@@ -233,9 +232,81 @@ func (m Model) viewBrowse() string {
 		Render(content)
 }
 
+// formatListLine renders an acronym row with its primary full form and a
+// count of any further senses
+func formatListLine(a acronym.Acronym) string {
+	line := fmt.Sprintf("%-6s %s", a.Acronym, a.Primary().FullForm)
+	if len(a.Senses) > 1 {
+		line += fmt.Sprintf(" (+%d)", len(a.Senses)-1)
+	}
+	if a.Unsafe() {
+		line += " ⚠"
+	}
+	return line
+}
+
+// renderDetails renders the detail pane listing every sense of an acronym
+func renderDetails(a *acronym.Acronym) string {
+	lines := []string{strings.Repeat("─", 60)}
+
+	// Single-sense acronyms keep the compact layout
+	if len(a.Senses) == 1 {
+		lines = append(lines,
+			fmt.Sprintf("%s → %s",
+				acronymStyle.Render(a.Acronym),
+				fullFormStyle.Render(a.Senses[0].FullForm)),
+		)
+		if warning := a.Senses[0].SafetyWarning(); warning != "" {
+			lines = append(lines, warningStyle.Render("⚠ "+warning))
+		}
+		lines = append(lines, definitionStyle.Render(a.Senses[0].Definition))
+		for _, meta := range senseMeta(a.Senses[0]) {
+			lines = append(lines, helpStyle.Render(meta))
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
+
+	lines = append(lines, fmt.Sprintf("%s %s",
+		acronymStyle.Render(a.Acronym),
+		helpStyle.Render(fmt.Sprintf("%d meanings", len(a.Senses)))))
+	for i, sense := range a.Senses {
+		lines = append(lines, fullFormStyle.Render(fmt.Sprintf("%d. %s", i+1, sense.FullForm)))
+		if warning := sense.SafetyWarning(); warning != "" {
+			lines = append(lines, senseDefinitionStyle.Inherit(warningStyle).Render("⚠ "+warning))
+		}
+		lines = append(lines, senseDefinitionStyle.Render(sense.Definition))
+		for _, meta := range senseMeta(sense) {
+			lines = append(lines, senseDefinitionStyle.Inherit(helpStyle).Render(meta))
+		}
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// senseMeta returns a line for each optional field set on a sense
+func senseMeta(sense acronym.Sense) []string {
+	var meta []string
+	if sense.Specialty != "" {
+		meta = append(meta, "Specialty: "+acronym.SpecialtyLabel(sense.Specialty))
+	}
+	if len(sense.Synonyms) > 0 {
+		meta = append(meta, "Also: "+strings.Join(sense.Synonyms, ", "))
+	}
+	for _, ref := range sense.References {
+		meta = append(meta, "See: "+ref)
+	}
+	if source := sense.SourceLabel(); source != "" {
+		meta = append(meta, "from "+source)
+	}
+	return meta
+}
+
 func (m Model) viewSearch() string {
 	// Use the textinput component with blinking cursor
-	searchLine := searchPromptStyle.Render("Search: ") + m.searchInput.View()
+	prompt := "Search: "
+	if m.reverse {
+		prompt = "Meaning: "
+	}
+	searchLine := searchPromptStyle.Render(prompt) + m.searchInput.View()
 
 	var results strings.Builder
 	if m.searchInput.Value() != "" {
@@ -261,7 +332,10 @@ func (m Model) viewSearch() string {
 		} else {
 			for i := 0; i < displayCount; i++ {
 				item := m.filtered[i]
-				line := fmt.Sprintf("%-6s %s", item.Acronym, item.FullForm)
+				line := formatListLine(item)
+				if score, ok := m.scores[item.Acronym]; ok {
+					line += fmt.Sprintf(" %.0f%%", score*100)
+				}
 				
 				if i == m.cursor {
 					results.WriteString(selectedItemStyle.Render("> " + line))
@@ -275,7 +349,11 @@ func (m Model) viewSearch() string {
 		}
 	}
 
-	help := helpStyle.Render("Type to search • ↑↓ navigate • Enter select • Esc cancel")
+	mode := "Tab search by meaning"
+	if m.reverse {
+		mode = "Tab search by acronym"
+	}
+	help := helpStyle.Render("Type to search • ↑↓ navigate • Enter select • " + mode + " • Esc cancel")
 
 	content := lipgloss.JoinVertical(
 		lipgloss.Left,
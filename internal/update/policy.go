@@ -0,0 +1,27 @@
+package update
+
+// Policy controls how the Terminal App keeps tmdr up to date. tmdr update
+// works whatever the policy.
+type Policy string
+
+const (
+	// PolicyAuto checks for a release at startup and installs it
+	PolicyAuto Policy = "auto"
+	// PolicyNotify checks for a release at startup and only announces it
+	PolicyNotify Policy = "notify"
+	// PolicyOff never contacts GitHub
+	PolicyOff Policy = "off"
+)
+
+// Policies lists every update policy
+var Policies = []Policy{PolicyAuto, PolicyNotify, PolicyOff}
+
+// IsValidPolicy reports whether p is one of Policies
+func IsValidPolicy(p Policy) bool {
+	for _, policy := range Policies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
@@ -111,23 +111,30 @@ func CheckForUpdateAsync() <-chan UpdateInfo {
 
 // CheckForUpdateWithAssets checks for updates and finds the right asset to download
 func CheckForUpdateWithAssets() UpdateInfo {
+	info, _ := Check()
+	return info
+}
+
+// Check finds the latest release and the asset for this platform, reporting
+// why when GitHub can't be reached
+func Check() (UpdateInfo, error) {
 	client := &http.Client{
 		Timeout: timeout,
 	}
 
 	resp, err := client.Get(githubAPIURL)
 	if err != nil {
-		return UpdateInfo{Available: false}
+		return UpdateInfo{}, fmt.Errorf("failed to check for updates: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return UpdateInfo{Available: false}
+		return UpdateInfo{}, fmt.Errorf("failed to check for updates: %s", resp.Status)
 	}
 
 	var release GitHubRelease
 	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
-		return UpdateInfo{Available: false}
+		return UpdateInfo{}, fmt.Errorf("failed to read release: %w", err)
 	}
 
 	// Remove 'v' prefix for comparison
@@ -136,7 +143,7 @@ func CheckForUpdateWithAssets() UpdateInfo {
 
 	// Check if update is available
 	if compareVersions(latestVersion, currentVersion) <= 0 {
-		return UpdateInfo{Available: false}
+		return UpdateInfo{Available: false, Version: latestVersion, URL: release.HTMLURL}, nil
 	}
 
 	// Find the right asset for this platform
@@ -155,7 +162,7 @@ func CheckForUpdateWithAssets() UpdateInfo {
 		URL:         release.HTMLURL,
 		DownloadURL: downloadURL,
 		AssetName:   assetName,
-	}
+	}, nil
 }
 
 // getAssetName returns the asset name for the current platform
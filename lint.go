@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/anthonylangham/tmdr/internal/lint"
+)
+
+// runLint validates dictionary files and returns the process exit code
+func runLint(shared *sharedFlags, args []string) int {
+	fs := newFlagSet("lint")
+	strict := fs.Bool("strict", false, "Treat warnings as errors")
+	maxDefinition := fs.Int("max-definition", lint.DefaultMaxDefinitionLength, "Warn about definitions longer than this many characters")
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	opts := lint.Options{MaxDefinitionLength: *maxDefinition}
+	errorCount, warningCount := 0, 0
+	for _, path := range fs.Args() {
+		issues, err := lint.File(path, opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			errorCount++
+			continue
+		}
+
+		for _, issue := range issues {
+			fmt.Printf("%s:%s\n", path, issue)
+		}
+		errors := lint.Errors(issues)
+		errorCount += errors
+		warningCount += len(issues) - errors
+	}
+
+	fmt.Printf("%d error(s), %d warning(s)\n", errorCount, warningCount)
+	if errorCount > 0 || (*strict && warningCount > 0) {
+		return exitFailure
+	}
+	return exitOK
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/output"
+)
+
+// runLookup looks up each term and returns the process exit code, which is
+// exitNotFound if any term has no exact match. A "-" argument reads
+// newline-separated terms from stdin. With --context, the meanings of each
+// acronym are ranked by the words in the given text.
+func runLookup(shared *sharedFlags, args []string) int {
+	fs := newFlagSet("lookup")
+	context := fs.String("context", "", "Rank meanings by the words in this text, such as the sentence the acronym came from")
+	shared.addOutputFlags(fs)
+	shared.addDictionaryFlags(fs)
+	fs.Parse(args)
+
+	terms, err := expandTerms(fs.Args(), os.Stdin)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading terms: %v\n", err)
+		return exitFailure
+	}
+	if len(terms) == 0 {
+		fmt.Fprintln(os.Stderr, "No acronyms to look up.")
+		return exitUsage
+	}
+
+	out, code := shared.printer()
+	if code != exitOK {
+		return code
+	}
+	repo, code := shared.load()
+	if code != exitOK {
+		return code
+	}
+
+	batch := len(terms) > 1
+	var results []output.Result
+	var misses []string
+	for i, term := range terms {
+		matches, err := lookupTerm(repo, term, shared.config.Fuzzy.Suggestions)
+		found := err == nil
+		if err != nil && !errors.Is(err, acronym.ErrNotFound) {
+			fmt.Fprintf(os.Stderr, "Error looking up %s: %v\n", term, err)
+			return exitCode(err)
+		}
+		if !found {
+			misses = append(misses, term)
+		}
+
+		var ranked []acronym.RankedSense
+		if found && *context != "" {
+			ranked = matches[0].Acronym.Disambiguate(*context)
+		}
+
+		if out.structured() {
+			for _, match := range matches {
+				if ranked != nil {
+					results = append(results, output.NewRankedResult(term, match, ranked))
+				} else {
+					results = append(results, output.NewResult(term, match))
+				}
+			}
+			continue
+		}
+
+		if batch && i > 0 {
+			fmt.Println()
+		}
+		if ranked != nil {
+			printRankedAcronym(matches[0].Acronym.Acronym, ranked)
+		} else if found {
+			printAcronym(&matches[0].Acronym)
+		} else {
+			printMiss(term, matches, !batch)
+		}
+	}
+
+	if out.structured() {
+		exitOnPrintError(out.print(results))
+	}
+
+	if batch && len(misses) > 0 {
+		fmt.Fprintf(os.Stderr, "\n%d of %d not found: %s\n", len(misses), len(terms), strings.Join(misses, ", "))
+	}
+	if len(misses) > 0 {
+		return exitNotFound
+	}
+	return exitOK
+}
+
+// lookupTerm returns the exact match for term. When there is none, it
+// returns up to limit fuzzy suggestions with an error wrapping
+// acronym.ErrNotFound.
+func lookupTerm(repo acronym.Repository, term string, limit int) ([]acronym.Match, error) {
+	a, err := repo.Find(strings.ToUpper(term))
+	if err == nil {
+		return []acronym.Match{{Acronym: *a, Type: acronym.MatchExact, Score: 1}}, nil
+	}
+	if !errors.Is(err, acronym.ErrNotFound) {
+		return nil, err
+	}
+
+	suggestions, fuzzyErr := repo.FindFuzzy(term, limit)
+	if fuzzyErr != nil && !errors.Is(fuzzyErr, acronym.ErrNoFuzzyMatch) {
+		return nil, fuzzyErr
+	}
+	return suggestions, err
+}
+
+// printRankedAcronym prints the senses of an acronym in the order the context
+// supports them, with the cues behind each
+func printRankedAcronym(name string, ranked []acronym.RankedSense) {
+	if len(ranked) == 1 {
+		fmt.Printf("%s → %s\n", name, ranked[0].Sense.FullForm)
+		printSenseDetails(ranked[0].Sense, "")
+		return
+	}
+
+	if ranked[0].Score == 0 {
+		fmt.Printf("%s has %d meanings; nothing in the context points to one:\n", name, len(ranked))
+	} else {
+		fmt.Printf("%s has %d meanings, most likely first:\n", name, len(ranked))
+	}
+	for i, r := range ranked {
+		fmt.Printf("  %d. %s", i+1, r.Sense.FullForm)
+		if len(r.Cues) > 0 {
+			fmt.Printf(" (%.0f%%, matched %s)", r.Score*100, strings.Join(r.Cues, ", "))
+		}
+		fmt.Println()
+		printSenseDetails(r.Sense, "     ")
+	}
+}
+
+// printMiss prints the not-found message for term with any suggestions
+func printMiss(term string, suggestions []acronym.Match, withHint bool) {
+	if len(suggestions) == 0 {
+		fmt.Printf("Acronym '%s' not found.\n", term)
+		if withHint {
+			fmt.Println("Try 'tmdr --help' for usage information.")
+		}
+		return
+	}
+
+	fmt.Printf("'%s' not found. Did you mean:\n", term)
+	for _, match := range suggestions {
+		primary := match.Acronym.Primary()
+		fmt.Printf("  %s → %s", match.Acronym.Acronym, primary.FullForm)
+		if primary.Layer != "" && primary.Layer != acronym.EmbeddedLayer {
+			fmt.Printf(" [%s]", primary.Layer)
+		}
+		fmt.Println()
+	}
+	if withHint {
+		fmt.Println("\nTry one of the suggestions above or 'tmdr --help' for usage.")
+	}
+}
+
+// expandTerms replaces each "-" argument with the terms read from stdin
+func expandTerms(args []string, stdin io.Reader) ([]string, error) {
+	var terms []string
+	readStdin := false
+	for _, arg := range args {
+		if arg != "-" {
+			terms = append(terms, arg)
+			continue
+		}
+		// Only the first "-" can consume stdin
+		if readStdin {
+			continue
+		}
+		readStdin = true
+		stdinTerms, err := readTerms(stdin)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, stdinTerms...)
+	}
+	return terms, nil
+}
+
+// readTerms reads one term per line, skipping blank lines and # comments
+func readTerms(r io.Reader) ([]string, error) {
+	var terms []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		terms = append(terms, line)
+	}
+	return terms, scanner.Err()
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/lsp"
+)
+
+// runLSP speaks the Language Server Protocol over stdin and stdout until the
+// editor exits, and returns the process exit code
+func runLSP(shared *sharedFlags, args []string) int {
+	fs := newFlagSet("lsp")
+	ignore := fs.String("ignore", "", "Comma-separated abbreviations not to warn about, such as OS")
+	fs.Bool("stdio", true, "Communicate over stdin and stdout (the only transport)")
+	shared.addSourceFlags(fs)
+	fs.Parse(args)
+
+	repo, code := shared.load()
+	if code != exitOK {
+		return code
+	}
+
+	var opts lsp.Options
+	if *ignore != "" {
+		opts.Ignore = strings.Split(*ignore, ",")
+	}
+
+	server, err := lsp.New(repo, opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitFailure
+	}
+	if err := server.Run(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitFailure
+	}
+	return exitOK
+}
@@ -7,90 +7,122 @@ import (
 	"strings"
 
 	"github.com/anthonylangham/tmdr/internal/acronym"
-	"github.com/anthonylangham/tmdr/internal/tui"
-	"github.com/anthonylangham/tmdr/internal/version"
-	tea "github.com/charmbracelet/bubbletea"
+	"github.com/anthonylangham/tmdr/internal/config"
+	"github.com/anthonylangham/tmdr/internal/output"
 )
 
 
 func main() {
+	// A broken config file stops every command but config, which can fix it
+	cfg, cfgErr := config.Load()
+	if cfgErr != nil {
+		cfg = config.Default()
+	}
+	shared := newSharedFlags(cfg)
 	var (
-		versionFlag     = flag.Bool("version", false, "Show version information")
-		helpFlag        = flag.Bool("help", false, "Show help information")
-		randomFlag      = flag.Bool("random", false, "Display a random acronym")
-		interactiveFlag = flag.Bool("interactive", false, "Launch interactive TUI mode")
-		iFlag           = flag.Bool("i", false, "Launch interactive TUI mode (shorthand)")
+		versionFlag = flag.Bool("version", false, "Show version information")
+		helpFlag    = flag.Bool("help", false, "Show help information")
+		randomFlag  = flag.Bool("random", false, "Display a random acronym")
+		browseFlag  bool
 	)
+	// --interactive and -i predate the browse command
+	flag.BoolVar(&browseFlag, "interactive", false, "Launch the Terminal App")
+	flag.BoolVar(&browseFlag, "i", false, "Launch the Terminal App (shorthand)")
+	shared.addOutputFlags(flag.CommandLine)
+	shared.addDictionaryFlags(flag.CommandLine)
+	flag.Usage = printHelp
 
 	flag.Parse()
 
-	if *versionFlag {
-		fmt.Printf("tmdr version %s\n", version.Version)
-		os.Exit(0)
+	// The flags that predate commands run the command they stand for
+	name, args := flag.Arg(0), flag.Args()
+	if len(args) > 0 {
+		args = args[1:]
+	}
+	switch {
+	case *helpFlag:
+		name, args = "help", nil
+	case *versionFlag:
+		name, args = "version", nil
+	case *randomFlag:
+		name, args = "random", nil
+	case browseFlag, flag.NArg() == 0:
+		name, args = "browse", nil
 	}
 
-	// Load the acronym repository from embedded data
-	repo, err := acronym.NewEmbeddedCSVRepository()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading acronym database: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Launch interactive TUI mode if requested or no arguments provided
-	if *interactiveFlag || *iFlag || (flag.NArg() == 0 && !*randomFlag && !*helpFlag && !*versionFlag) {
-		model := tui.NewModel(repo)
-		program := tea.NewProgram(model, tea.WithAltScreen())
-		
-		if _, err := program.Run(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error running TUI: %v\n", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
+	if cfgErr != nil && name != "config" {
+		fmt.Fprintf(os.Stderr, "Error in config: %v\n", cfgErr)
+		os.Exit(exitUsage)
 	}
 
-	if *helpFlag {
-		printHelp()
-		os.Exit(0)
+	if cmd, ok := findCommand(name); ok {
+		os.Exit(cmd.run(shared, args))
 	}
 
-	if *randomFlag {
-		a, err := repo.Random()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error getting random acronym: %v\n", err)
-			os.Exit(1)
-		}
-		printAcronym(a)
-		os.Exit(0)
+	// Anything else is an acronym, or acronyms, to look up
+	os.Exit(runLookup(shared, flag.Args()))
+}
+
+// printer renders lookup results in the format chosen on the command line
+type printer struct {
+	format   string
+	template string
+}
+
+// structured reports whether results should be rendered for machines rather
+// than as plain text
+func (p printer) structured() bool {
+	return p.template != "" || p.format != output.FormatText
+}
+
+func (p printer) print(results []output.Result) error {
+	if p.template != "" {
+		return output.WriteTemplate(os.Stdout, p.template, results)
 	}
+	return output.Write(os.Stdout, p.format, results)
+}
 
-	// Look up the provided acronym (case-insensitive)
-	acronymStr := strings.ToUpper(flag.Arg(0))
-	a, err := repo.Find(acronymStr)
+func exitOnPrintError(err error) {
 	if err != nil {
-		// Try fuzzy matching
-		fuzzyMatches, fuzzyErr := repo.FindFuzzy(flag.Arg(0), 3)
-		if fuzzyErr != nil {
-			fmt.Printf("Acronym '%s' not found.\n", flag.Arg(0))
-			fmt.Println("Try 'tmdr --help' for usage information.")
-			os.Exit(1)
-		}
-		
-		// Show fuzzy match suggestions
-		fmt.Printf("'%s' not found. Did you mean:\n", flag.Arg(0))
-		for _, match := range fuzzyMatches {
-			fmt.Printf("  %s → %s\n", match.Acronym, match.FullForm)
-		}
-		fmt.Println("\nTry one of the suggestions above or 'tmdr --help' for usage.")
-		os.Exit(1)
+		fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
+		os.Exit(exitFailure)
 	}
-	
-	printAcronym(a)
 }
 
 func printAcronym(a *acronym.Acronym) {
-	fmt.Printf("%s → %s\n", a.Acronym, a.FullForm)
-	if a.Definition != "" {
-		fmt.Println(a.Definition)
+	// Keep the compact layout when there is nothing to disambiguate
+	if len(a.Senses) == 1 {
+		fmt.Printf("%s → %s\n", a.Acronym, a.Senses[0].FullForm)
+		printSenseDetails(a.Senses[0], "")
+		return
+	}
+
+	fmt.Printf("%s has %d meanings:\n", a.Acronym, len(a.Senses))
+	for i, sense := range a.Senses {
+		fmt.Printf("  %d. %s\n", i+1, sense.FullForm)
+		printSenseDetails(sense, "     ")
+	}
+}
+
+// printSenseDetails prints the definition and any optional fields of a sense
+func printSenseDetails(sense acronym.Sense, indent string) {
+	if warning := sense.SafetyWarning(); warning != "" {
+		fmt.Printf("%s⚠ %s\n", indent, warning)
+	}
+	if sense.Definition != "" {
+		fmt.Printf("%s%s\n", indent, sense.Definition)
+	}
+	if sense.Specialty != "" {
+		fmt.Printf("%sSpecialty: %s\n", indent, acronym.SpecialtyLabel(sense.Specialty))
+	}
+	if len(sense.Synonyms) > 0 {
+		fmt.Printf("%sAlso: %s\n", indent, strings.Join(sense.Synonyms, ", "))
+	}
+	for _, ref := range sense.References {
+		fmt.Printf("%sSee: %s\n", indent, ref)
+	}
+	if source := sense.SourceLabel(); source != "" {
+		fmt.Printf("%s(from %s)\n", indent, source)
 	}
 }
 
@@ -99,12 +131,33 @@ func printHelp() {
 	fmt.Println()
 	fmt.Println("Usage:")
 	fmt.Println("  tmdr                   Launch Terminal App")
-	fmt.Println("  tmdr <acronym>         Look up a medical acronym inline")
-	fmt.Println("  tmdr --random          Display a random acronym inline")
-	fmt.Println("  tmdr --version         Show version information")
-	fmt.Println("  tmdr --help            Show this help message")
+	fmt.Println("  tmdr <acronym>...      Look up one or more medical acronyms inline")
+	fmt.Println("  tmdr -                 Look up newline-separated acronyms from stdin")
+	fmt.Println("  tmdr <command> [flags] [args]")
+	fmt.Println()
+	fmt.Println("Commands:")
+	for _, cmd := range commands {
+		if !cmd.hidden {
+			fmt.Printf("  %-12s %s\n", cmd.name, cmd.summary)
+		}
+	}
 	fmt.Println()
-	fmt.Println("Example:")
+	fmt.Println("Options:")
+	fmt.Println("  --format <format>      Output as text, json, yaml, tsv or markdown")
+	fmt.Println("  --template <template>  Render each result with a Go text/template")
+	fmt.Println("  --scorer <name>        Rank suggestions by levenshtein, damerau, keyboard,")
+	fmt.Println("                         jaro-winkler or phonetic")
+	fmt.Println("  --specialty <name>     Only show meanings from one specialty, such as")
+	fmt.Println("                         cardiology, neurology or pharmacology")
+	fmt.Println("  --version              Show version information")
+	fmt.Println("  --help                 Show this help message")
+	fmt.Println()
+	fmt.Println("Exit codes:")
+	fmt.Println("  0 success, 1 problems found or error, 2 invalid usage,")
+	fmt.Println("  3 not found, 4 dictionary failed to load")
+	fmt.Println()
+	fmt.Println("Examples:")
 	fmt.Println("  tmdr abg               Look up ABG (Arterial Blood Gas)")
-	
-}
\ No newline at end of file
+	fmt.Println("  tmdr help search       Show the flags of the search command")
+	fmt.Println("  tmdr config list       Show settings, from ~/.config/tmdr/config.yaml")
+}
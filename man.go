@@ -0,0 +1,158 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/config"
+	"github.com/anthonylangham/tmdr/internal/version"
+)
+
+// runMan prints the tmdr(1) manual page and returns the process exit code
+func runMan(_ *sharedFlags, args []string) int {
+	fs := newFlagSet("man")
+	fs.Parse(args)
+
+	writeManPage(os.Stdout)
+	return exitOK
+}
+
+// writeManPage writes the manual page in roff, built from the command table
+// and the shared flags so it can't drift from tmdr --help
+func writeManPage(w io.Writer) {
+	fmt.Fprintf(w, ".TH TMDR 1 \"\" \"tmdr %s\" \"User Commands\"\n", roff(version.Version))
+	fmt.Fprintln(w, ".SH NAME")
+	fmt.Fprintln(w, `tmdr \- too medical; didn't read: look up medical acronyms`)
+
+	fmt.Fprintln(w, ".SH SYNOPSIS")
+	fmt.Fprintln(w, ".B tmdr")
+	fmt.Fprintln(w, ".RI [ options ] [ acronym ...]")
+	fmt.Fprintln(w, ".br")
+	fmt.Fprintln(w, ".B tmdr")
+	fmt.Fprintln(w, ".I command")
+	fmt.Fprintln(w, ".RI [ flags ] [ args ]")
+
+	fmt.Fprintln(w, ".SH DESCRIPTION")
+	fmt.Fprintln(w, "tmdr explains medical acronyms and abbreviations: every meaning, the one the surrounding")
+	fmt.Fprintln(w, "words point to, and a warning for abbreviations on patient safety do-not-use lists.")
+	fmt.Fprintln(w, "With no arguments it launches the Terminal App; with acronyms as arguments it looks them up.")
+
+	fmt.Fprintln(w, ".SH COMMANDS")
+	for _, cmd := range commands {
+		if cmd.hidden {
+			continue
+		}
+		fmt.Fprintln(w, ".TP")
+		fmt.Fprintf(w, ".B %s\n", roffArg(strings.TrimSpace("tmdr "+cmd.name+" "+cmd.synopsis)))
+		fmt.Fprintln(w, roff(strings.TrimSpace(cmd.summary+". "+cmd.detail)))
+	}
+	fmt.Fprintln(w, ".PP")
+	fmt.Fprintln(w, "Run")
+	fmt.Fprintln(w, ".B tmdr help")
+	fmt.Fprintln(w, ".I command")
+	fmt.Fprintln(w, "for the flags of a command.")
+
+	fmt.Fprintln(w, ".SH OPTIONS")
+	fmt.Fprintln(w, "The output options apply to lookup, search, reverse and random, \\-\\-specialty to every")
+	fmt.Fprintln(w, "command that reads the dictionary, and \\-\\-scorer to lookup, serve and mcp, which suggest")
+	fmt.Fprintln(w, "corrections. They can be given before or after the command.")
+	fs := flag.NewFlagSet("tmdr", flag.ContinueOnError)
+	shared := newSharedFlags(config.Default())
+	shared.addOutputFlags(fs)
+	shared.addDictionaryFlags(fs)
+	fs.Bool("help", false, "Show help information")
+	fs.Bool("version", false, "Show version information")
+	fs.VisitAll(func(f *flag.Flag) {
+		name, usage := flag.UnquoteUsage(f)
+		fmt.Fprintln(w, ".TP")
+		if name == "" {
+			fmt.Fprintf(w, ".B \\-\\-%s\n", roff(f.Name))
+		} else {
+			fmt.Fprintf(w, ".BI \\-\\-%s \" %s\"\n", roff(f.Name), roff(name))
+		}
+		fmt.Fprintln(w, roff(usage))
+	})
+
+	fmt.Fprintln(w, ".SH EXIT STATUS")
+	for _, status := range []struct {
+		code int
+		text string
+	}{
+		{exitOK, "Success."},
+		{exitFailure, "check or lint found problems, or an unexpected error."},
+		{exitUsage, "Invalid flags or arguments."},
+		{exitNotFound, "An acronym, search or reverse lookup found nothing."},
+		{exitLoadFailed, "A dictionary could not be loaded."},
+	} {
+		fmt.Fprintln(w, ".TP")
+		fmt.Fprintf(w, ".B %d\n", status.code)
+		fmt.Fprintln(w, roff(status.text))
+	}
+
+	fmt.Fprintln(w, ".SH FILES")
+	fmt.Fprintln(w, ".TP")
+	fmt.Fprintln(w, `.I ~/.config/tmdr/config.yaml`)
+	fmt.Fprintln(w, "Settings, shown and changed with")
+	fmt.Fprintln(w, ".BR \"tmdr config\" .")
+	fmt.Fprintln(w, "Run")
+	fmt.Fprintln(w, ".B tmdr config list")
+	fmt.Fprintln(w, "for the keys and their values.")
+	fmt.Fprintln(w, ".TP")
+	fmt.Fprintln(w, `.I ~/.config/tmdr/dicts/`)
+	fmt.Fprintln(w, "Personal or team dictionaries in CSV, JSON or YAML, merged over the built-in data.")
+	fmt.Fprintln(w, ".TP")
+	fmt.Fprintln(w, `.I .tmdr/`)
+	fmt.Fprintln(w, "Project dictionaries, found in the current directory or any parent up to the repository root.")
+	fmt.Fprintln(w, "They take precedence over personal dictionaries.")
+
+	fmt.Fprintln(w, ".SH ENVIRONMENT")
+	fmt.Fprintln(w, ".TP")
+	fmt.Fprintln(w, ".B XDG_CONFIG_HOME")
+	fmt.Fprintln(w, "Moves the personal dictionaries to")
+	fmt.Fprintln(w, `.I $XDG_CONFIG_HOME/tmdr/dicts/`)
+	fmt.Fprintln(w, "and the config file to")
+	fmt.Fprintln(w, `.IR $XDG_CONFIG_HOME/tmdr/config.yaml .`)
+	fmt.Fprintln(w, ".TP")
+	fmt.Fprintln(w, ".B "+config.PathEnv)
+	fmt.Fprintln(w, "Reads settings from this file instead.")
+	for _, k := range config.Keys {
+		fmt.Fprintln(w, ".TP")
+		fmt.Fprintln(w, ".B "+k.Env())
+		fmt.Fprintf(w, "%s. Overrides the %s setting", roff(k.Help), roff(k.Name))
+		if k.IsList() {
+			fmt.Fprint(w, "; separate paths with colons")
+		}
+		fmt.Fprintln(w, ".")
+	}
+
+	fmt.Fprintln(w, ".SH EXAMPLES")
+	for _, example := range []struct{ command, text string }{
+		{"tmdr abg", "Look up ABG."},
+		{`tmdr lookup --context "diastolic murmur on echo" ms`, "Rank the meanings of MS by the words around it."},
+		{"tmdr --format json search kidney", "Search meanings for a word and print JSON."},
+		{"tmdr check notes.md", "Find do-not-use abbreviations in a document."},
+		{"source <(tmdr completion bash)", "Complete commands and acronyms in bash."},
+	} {
+		fmt.Fprintln(w, ".TP")
+		fmt.Fprintf(w, ".B %s\n", roffArg(example.command))
+		fmt.Fprintln(w, roff(example.text))
+	}
+}
+
+// roff escapes text for a man page line
+func roff(text string) string {
+	text = strings.ReplaceAll(text, `\`, `\e`)
+	text = strings.ReplaceAll(text, "-", `\-`)
+	if strings.HasPrefix(text, ".") || strings.HasPrefix(text, "'") {
+		text = `\&` + text
+	}
+	return text
+}
+
+// roffArg escapes text as a single macro argument
+func roffArg(text string) string {
+	return `"` + strings.ReplaceAll(roff(text), `"`, `\(dq`) + `"`
+}
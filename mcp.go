@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/anthonylangham/tmdr/internal/mcp"
+)
+
+// runMCP serves lookup tools to AI assistants over the Model Context
+// Protocol on stdin and stdout, and returns the process exit code
+func runMCP(shared *sharedFlags, args []string) int {
+	fs := newFlagSet("mcp")
+	shared.addDictionaryFlags(fs)
+	fs.Parse(args)
+
+	repo, code := shared.load()
+	if code != exitOK {
+		return code
+	}
+
+	if err := mcp.New(repo).Run(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitFailure
+	}
+	return exitOK
+}
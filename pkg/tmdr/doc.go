@@ -0,0 +1,34 @@
+// Package tmdr looks up medical acronyms and abbreviations in Go services,
+// with the same dictionary and lookups as the tmdr command.
+//
+// A Dictionary holds the built-in dictionary, optionally merged with
+// dictionary files or entries of your own:
+//
+//	damerau, _ := tmdr.ScorerByName("damerau")
+//	dict, err := tmdr.New(
+//		tmdr.WithSource("/etc/hospital/abbreviations.yaml"),
+//		tmdr.WithScorer(damerau),
+//	)
+//	if err != nil {
+//		return err
+//	}
+//
+//	a, err := dict.Lookup(ctx, "ABG")
+//	if errors.Is(err, tmdr.ErrNotFound) {
+//		suggestions, _ := dict.Fuzzy(ctx, "ABG", 3)
+//		...
+//	}
+//	fmt.Println(a.Primary().FullForm) // Arterial Blood Gas
+//
+// Lookups run in memory and are safe for concurrent use. Each method checks
+// its context first, so a request that has already been cancelled or timed
+// out fails fast with the context's error.
+//
+// # Errors
+//
+// Lookups that find nothing return an error matching ErrNotFound,
+// ErrNoFuzzyMatch or ErrEmptyRepository with errors.Is. Lookup returns a
+// *NotFoundError naming the acronym, and New returns a *SourceError naming
+// any dictionary file that could not be loaded. When the file is malformed
+// the SourceError wraps a *ParseError with the line and column at fault.
+package tmdr
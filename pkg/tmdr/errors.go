@@ -0,0 +1,61 @@
+package tmdr
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+)
+
+// The lookup sentinels are the ones the tmdr command and its servers use, so
+// errors.Is works the same on either side of the library
+var (
+	// ErrNotFound is matched by the error Lookup and Disambiguate return for
+	// an acronym that isn't in the dictionary
+	ErrNotFound = acronym.ErrNotFound
+	// ErrNoFuzzyMatch is matched by the error Fuzzy returns when nothing
+	// resembles the query
+	ErrNoFuzzyMatch = acronym.ErrNoFuzzyMatch
+	// ErrEmptyRepository is matched by the error Random returns when the
+	// dictionary has no acronyms
+	ErrEmptyRepository = acronym.ErrEmptyRepository
+	// ErrUnknownScorer is matched by the error ScorerByName returns for a
+	// name that isn't one of ScorerNames
+	ErrUnknownScorer = errors.New("unknown scorer")
+)
+
+// NotFoundError reports an acronym that isn't in the dictionary. It matches
+// ErrNotFound with errors.Is.
+type NotFoundError struct {
+	Acronym string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("acronym '%s' not found", e.Acronym)
+}
+
+// Is reports whether target is ErrNotFound
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// ParseError reports a malformed dictionary file with the line and column of
+// the problem. New wraps it in a *SourceError; find it with errors.As.
+type ParseError = acronym.ParseError
+
+// SourceError reports a dictionary file or set of entries New could not
+// load. Err is the underlying error, such as an *fs.PathError for a missing
+// file.
+type SourceError struct {
+	// Source is the file path, or "entries" for WithEntries
+	Source string
+	Err    error
+}
+
+func (e *SourceError) Error() string {
+	return fmt.Sprintf("failed to load dictionary %s: %v", e.Source, e.Err)
+}
+
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
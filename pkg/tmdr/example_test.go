@@ -0,0 +1,199 @@
+package tmdr_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/pkg/tmdr"
+)
+
+func ExampleNew() {
+	dict, err := tmdr.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	a, err := dict.Lookup(context.Background(), "abg")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(a.Acronym, "-", a.Primary().FullForm)
+	// Output:
+	// ABG - Arterial Blood Gas
+}
+
+func ExampleWithEntries() {
+	dict, err := tmdr.New(tmdr.WithEntries(
+		tmdr.Entry{Acronym: "WCC", FullForm: "White Cell Count", Specialty: "laboratory"},
+		tmdr.Entry{Acronym: "ABG", FullForm: "Arterial Blood Gas", Definition: "Drawn by the ward team"},
+	))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+	for _, name := range []string{"WCC", "ABG"} {
+		a, err := dict.Lookup(ctx, name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s: %s, from %s\n", a.Acronym, a.Primary().FullForm, a.Primary().Source)
+	}
+	// Output:
+	// WCC: White Cell Count, from entries
+	// ABG: Arterial Blood Gas, from entries
+}
+
+func ExampleWithSpecialty() {
+	dict, err := tmdr.New(tmdr.WithSpecialty("cardiology"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	a, err := dict.Lookup(context.Background(), "MS")
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, s := range a.Senses {
+		fmt.Println(s.FullForm)
+	}
+	// Output:
+	// Mitral Stenosis
+}
+
+func ExampleDictionary_Lookup_notFound() {
+	dict, err := tmdr.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = dict.Lookup(context.Background(), "ABX")
+	var notFound *tmdr.NotFoundError
+	if errors.As(err, &notFound) {
+		fmt.Println(notFound.Acronym, "is not in the dictionary")
+	}
+	fmt.Println(errors.Is(err, tmdr.ErrNotFound))
+	// Output:
+	// ABX is not in the dictionary
+	// true
+}
+
+func ExampleDictionary_Disambiguate() {
+	dict, err := tmdr.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ranked, err := dict.Disambiguate(context.Background(), "MS", "Give MS 10 mg IV for pain")
+	if err != nil {
+		log.Fatal(err)
+	}
+	top := ranked[0]
+	fmt.Printf("%s (%.0f%%, matched %s)\n", top.FullForm, top.Score*100, strings.Join(top.Cues, ", "))
+	fmt.Println(top.DoNotUse, top.Alternative)
+	// Output:
+	// Morphine Sulfate (100%, matched mg, IV, pain)
+	// true morphine sulfate
+}
+
+func ExampleDictionary_Fuzzy() {
+	dict, err := tmdr.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	matches, err := dict.Fuzzy(context.Background(), "gba", 3)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, m := range matches {
+		fmt.Println(m.Acronym.Acronym)
+	}
+	// Output:
+	// ABG
+}
+
+func ExampleDictionary_Search() {
+	dict, err := tmdr.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	matches, err := dict.Search(context.Background(), "kidney function", tmdr.SearchOptions{Limit: 2})
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, m := range matches {
+		fmt.Println(m.Acronym.Acronym, "-", m.Acronym.Primary().FullForm)
+	}
+	// Output:
+	// AKI - Acute Kidney Injury
+	// GFR - Glomerular Filtration Rate
+}
+
+func ExampleDictionary_Expand() {
+	dict, err := tmdr.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	text, err := dict.Expand(context.Background(), "Check the ABG, then the ABG again.", tmdr.ExpandInline)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(text)
+	// Output:
+	// Check the ABG (Arterial Blood Gas), then the ABG again.
+}
+
+func ExampleScorerFunc() {
+	// Suggest acronyms that start with the query's first letter, shortest
+	// first
+	sameInitial := tmdr.ScorerFunc(func(query, candidate string) float64 {
+		if query == "" || candidate[0] != query[0] {
+			return 0
+		}
+		return 1 / float64(len(candidate))
+	})
+
+	dict, err := tmdr.New(tmdr.WithScorer(sameInitial))
+	if err != nil {
+		log.Fatal(err)
+	}
+	matches, err := dict.Fuzzy(context.Background(), "uxx", 2)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, m := range matches {
+		fmt.Println(m.Acronym.Acronym)
+	}
+	// Output:
+	// U
+	// UA
+}
+
+func ExampleScorerByName() {
+	damerau, err := tmdr.ScorerByName("damerau")
+	if err != nil {
+		log.Fatal(err)
+	}
+	dict, err := tmdr.New(tmdr.WithScorer(damerau))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	matches, err := dict.Fuzzy(context.Background(), "ekq", 1)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(matches[0].Acronym.Acronym)
+
+	_, err = tmdr.ScorerByName("soundex")
+	fmt.Println(errors.Is(err, tmdr.ErrUnknownScorer))
+	// Output:
+	// EKG
+	// true
+}
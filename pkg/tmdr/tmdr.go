@@ -0,0 +1,300 @@
+package tmdr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/expand"
+)
+
+// sourceLayer names the layer of dictionaries added with WithSource and
+// WithEntries
+const sourceLayer = "source"
+
+// Scorer decides which acronyms Fuzzy suggests for a query and in what
+// order. Fuzzy calls Score with the query and each acronym in the dictionary
+// in turn, both written the way Lookup matches them: trimmed, upper case and
+// with full-width letters folded, so "ａｂｇ " arrives as "ABG". Return a score
+// from 0 to 1, higher for a closer resemblance, or 0 to leave the candidate
+// out. Fuzzy returns the highest scores first.
+//
+// A Dictionary may call Score from several goroutines at once, so a Scorer
+// must be safe for concurrent use.
+type Scorer interface {
+	Score(query, candidate string) float64
+}
+
+// ScorerFunc lets a function be passed to WithScorer
+type ScorerFunc func(query, candidate string) float64
+
+// Score returns f(query, candidate)
+func (f ScorerFunc) Score(query, candidate string) float64 {
+	return f(query, candidate)
+}
+
+// ScorerNames lists the built-in scorers, default first
+func ScorerNames() []string {
+	return append([]string(nil), acronym.ScorerNames...)
+}
+
+// ScorerByName returns a built-in scorer: levenshtein (the default),
+// damerau, keyboard, jaro-winkler or phonetic
+func ScorerByName(name string) (Scorer, error) {
+	s, err := acronym.ScorerByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w '%s' (want one of %s)", ErrUnknownScorer, name, strings.Join(acronym.ScorerNames, ", "))
+	}
+	return s, nil
+}
+
+// Option configures a Dictionary
+type Option func(*options)
+
+type options struct {
+	layers     []func() (acronym.Repository, error)
+	overlays   bool
+	noEmbedded bool
+	scorer     Scorer
+	specialty  string
+}
+
+// WithSource adds a CSV, JSON or YAML dictionary file, in the same formats
+// tmdr lint checks. Sources added later take precedence: their senses rank
+// first and replace built-in senses with the same full form.
+func WithSource(path string) Option {
+	return func(o *options) {
+		o.layers = append(o.layers, func() (acronym.Repository, error) {
+			repo, err := acronym.NewFileRepository(path)
+			if err != nil {
+				return nil, &SourceError{Source: path, Err: err}
+			}
+			return repo, nil
+		})
+	}
+}
+
+// WithEntries adds acronyms held in memory, such as ones loaded from a
+// database. They take precedence like a source added at the same point.
+func WithEntries(entries ...Entry) Option {
+	converted := toEntries(entries)
+	return func(o *options) {
+		o.layers = append(o.layers, func() (acronym.Repository, error) {
+			repo, err := acronym.NewEntryRepository("entries", converted)
+			if err != nil {
+				return nil, &SourceError{Source: "entries", Err: err}
+			}
+			return repo, nil
+		})
+	}
+}
+
+// WithOverlays also loads the dictionaries the tmdr command does: the
+// nearest repo-local .tmdr folder and the user dictionary folder. They rank
+// below sources added with WithSource and WithEntries.
+func WithOverlays() Option {
+	return func(o *options) {
+		o.overlays = true
+	}
+}
+
+// WithoutEmbedded leaves out the built-in dictionary, so only added sources
+// are looked up
+func WithoutEmbedded() Option {
+	return func(o *options) {
+		o.noEmbedded = true
+	}
+}
+
+// WithScorer changes how Fuzzy ranks candidates; see ScorerByName for the
+// built-in scorers
+func WithScorer(s Scorer) Option {
+	return func(o *options) {
+		o.scorer = s
+	}
+}
+
+// WithSpecialty only returns senses from one specialty, by name or alias
+// such as "cardiology" or "renal". Acronyms with no sense in the specialty are
+// left out altogether.
+func WithSpecialty(name string) Option {
+	return func(o *options) {
+		o.specialty = name
+	}
+}
+
+// Dictionary looks up medical acronyms. It is safe for concurrent use.
+type Dictionary struct {
+	repo acronym.Repository
+}
+
+// New loads the built-in dictionary together with any sources the options
+// add. A source that can't be loaded is reported as a *SourceError.
+func New(opts ...Option) (*Dictionary, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	// Later sources take precedence, so they go first
+	var layers []acronym.Layer
+	for i := len(o.layers) - 1; i >= 0; i-- {
+		repo, err := o.layers[i]()
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, acronym.Layer{Name: sourceLayer, Repo: repo})
+	}
+
+	if o.overlays {
+		overlay, err := acronym.NewOverlayRepository()
+		if err != nil {
+			return nil, &SourceError{Source: "overlays", Err: err}
+		}
+		for _, layer := range overlay.Layers() {
+			if layer.Name != acronym.EmbeddedLayer || !o.noEmbedded {
+				layers = append(layers, layer)
+			}
+		}
+	} else if !o.noEmbedded {
+		embedded, err := acronym.NewEmbeddedRepository()
+		if err != nil {
+			return nil, &SourceError{Source: acronym.EmbeddedSource, Err: err}
+		}
+		layers = append(layers, acronym.Layer{Name: acronym.EmbeddedLayer, Repo: embedded})
+	}
+
+	composite := acronym.NewCompositeRepository(layers...)
+	if o.scorer != nil {
+		composite.SetScorer(o.scorer)
+	}
+
+	var repo acronym.Repository = composite
+	if o.specialty != "" {
+		filtered := acronym.NewSpecialtyRepository(composite, o.specialty)
+		if all, _ := filtered.All(); len(all) == 0 {
+			return nil, fmt.Errorf("no acronyms in specialty '%s'", o.specialty)
+		}
+		repo = filtered
+	}
+	return &Dictionary{repo: repo}, nil
+}
+
+// Lookup returns every sense of an acronym. Case and Unicode width are
+// ignored, so "abg", "ＡＢＧ" and "ABG" are the same. An unknown acronym is
+// reported as a *NotFoundError.
+func (d *Dictionary) Lookup(ctx context.Context, name string) (Acronym, error) {
+	if err := ctx.Err(); err != nil {
+		return Acronym{}, err
+	}
+	a, err := d.find(name)
+	if err != nil {
+		return Acronym{}, err
+	}
+	return fromAcronym(*a), nil
+}
+
+// Disambiguate returns the senses of an acronym ranked by how strongly text,
+// such as the sentence the acronym came from, points to each
+func (d *Dictionary) Disambiguate(ctx context.Context, name, text string) ([]RankedSense, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	a, err := d.find(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := a.Disambiguate(text)
+	converted := make([]RankedSense, len(ranked))
+	for i, r := range ranked {
+		converted[i] = RankedSense{Sense: fromSense(r.Sense), Score: r.Score, Cues: r.Cues}
+	}
+	return converted, nil
+}
+
+func (d *Dictionary) find(name string) (*acronym.Acronym, error) {
+	a, err := d.repo.Find(name)
+	if errors.Is(err, ErrNotFound) {
+		return nil, &NotFoundError{Acronym: name}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up '%s': %w", name, err)
+	}
+	return a, nil
+}
+
+// Fuzzy returns up to limit acronyms resembling query, best first, such as
+// suggestions for a misspelling. It returns ErrNoFuzzyMatch when nothing
+// resembles the query.
+func (d *Dictionary) Fuzzy(ctx context.Context, query string, limit int) ([]Match, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	matches, err := d.repo.FindFuzzy(query, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w for '%s'", ErrNoFuzzyMatch, query)
+	}
+	return fromMatches(matches), nil
+}
+
+// Search ranks acronyms by the words in their full forms, synonyms,
+// definitions and specialties. No results is not an error.
+func (d *Dictionary) Search(ctx context.Context, query string, opts SearchOptions) ([]Match, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	matches, err := d.repo.Search(query, acronym.SearchOptions{
+		Limit:  opts.Limit,
+		Prefix: opts.Prefix,
+		Fuzzy:  opts.Fuzzy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+	return fromMatches(matches), nil
+}
+
+// Random returns a random acronym, or an error matching ErrEmptyRepository
+// when there are none
+func (d *Dictionary) Random(ctx context.Context) (Acronym, error) {
+	if err := ctx.Err(); err != nil {
+		return Acronym{}, err
+	}
+	a, err := d.repo.Random()
+	if err != nil {
+		return Acronym{}, err
+	}
+	return fromAcronym(*a), nil
+}
+
+// All returns every acronym in the dictionary
+func (d *Dictionary) All(ctx context.Context) ([]Acronym, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	all, err := d.repo.All()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list acronyms: %w", err)
+	}
+
+	converted := make([]Acronym, len(all))
+	for i, a := range all {
+		converted[i] = fromAcronym(a)
+	}
+	return converted, nil
+}
+
+// Expand annotates the known acronyms in text with the sense the surrounding
+// words point to. Markdown code blocks and code spans are left untouched.
+func (d *Dictionary) Expand(ctx context.Context, text string, style ExpandStyle) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return expand.Expand(text, d.repo, expand.Options{Style: expand.Style(style)})
+}
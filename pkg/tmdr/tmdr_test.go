@@ -0,0 +1,252 @@
+package tmdr_test
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/anthonylangham/tmdr/pkg/tmdr"
+)
+
+// writeDict writes a dictionary file for a test and returns its path
+func writeDict(t *testing.T, name, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func newDictionary(t *testing.T, opts ...tmdr.Option) *tmdr.Dictionary {
+	t.Helper()
+	dict, err := tmdr.New(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dict
+}
+
+// fullForms lists the full forms of an acronym's senses in rank order
+func fullForms(a tmdr.Acronym) string {
+	var forms []string
+	for _, s := range a.Senses {
+		forms = append(forms, s.FullForm)
+	}
+	return strings.Join(forms, ", ")
+}
+
+func TestLookupErrors(t *testing.T) {
+	ctx := context.Background()
+	dict := newDictionary(t)
+
+	_, err := dict.Lookup(ctx, "ZZQX")
+	if !errors.Is(err, tmdr.ErrNotFound) {
+		t.Errorf("Lookup of an unknown acronym = %v, want ErrNotFound", err)
+	}
+	var notFound *tmdr.NotFoundError
+	if !errors.As(err, &notFound) || notFound.Acronym != "ZZQX" {
+		t.Errorf("Lookup of an unknown acronym = %v, want a *NotFoundError for ZZQX", err)
+	}
+	if _, err := dict.Disambiguate(ctx, "ZZQX", "some text"); !errors.Is(err, tmdr.ErrNotFound) {
+		t.Errorf("Disambiguate of an unknown acronym = %v, want ErrNotFound", err)
+	}
+
+	if _, err := dict.Fuzzy(ctx, "QQQQQQQQQQ", 3); !errors.Is(err, tmdr.ErrNoFuzzyMatch) {
+		t.Errorf("Fuzzy with nothing alike = %v, want ErrNoFuzzyMatch", err)
+	}
+
+	empty := newDictionary(t, tmdr.WithoutEmbedded())
+	if _, err := empty.Random(ctx); !errors.Is(err, tmdr.ErrEmptyRepository) {
+		t.Errorf("Random on an empty dictionary = %v, want ErrEmptyRepository", err)
+	}
+
+	if _, err := tmdr.ScorerByName("soundex"); !errors.Is(err, tmdr.ErrUnknownScorer) {
+		t.Errorf("ScorerByName(soundex) = %v, want ErrUnknownScorer", err)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := dict.Lookup(cancelled, "ABG"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Lookup with a cancelled context = %v, want context.Canceled", err)
+	}
+}
+
+func TestSourceErrors(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing.csv")
+	_, err := tmdr.New(tmdr.WithSource(missing))
+	var sourceErr *tmdr.SourceError
+	if !errors.As(err, &sourceErr) || sourceErr.Source != missing {
+		t.Fatalf("New with a missing file = %v, want a *SourceError for it", err)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("New with a missing file = %v, want it to match fs.ErrNotExist", err)
+	}
+
+	malformed := writeDict(t, "bad.yaml", "- acronym: ABG\n  full_form: Arterial Blood Gas\n- acronym: \n  full_form: Nothing\n")
+	_, err = tmdr.New(tmdr.WithSource(malformed))
+	var parseErr *tmdr.ParseError
+	if !errors.As(err, &sourceErr) || !errors.As(err, &parseErr) {
+		t.Fatalf("New with a malformed file = %v, want a *SourceError wrapping a *ParseError", err)
+	}
+	if parseErr.Line != 3 {
+		t.Errorf("ParseError at line %d, want 3", parseErr.Line)
+	}
+
+	_, err = tmdr.New(tmdr.WithEntries(tmdr.Entry{FullForm: "No Acronym"}))
+	if !errors.As(err, &sourceErr) || sourceErr.Source != "entries" {
+		t.Errorf("New with an invalid entry = %v, want a *SourceError for entries", err)
+	}
+}
+
+func TestWithSource(t *testing.T) {
+	ctx := context.Background()
+	first := writeDict(t, "first.csv", "acronym,definition\n"+
+		"ABG,Arterial Blood Gas – Our own definition\n"+
+		"ABG,Air Bubble Gauge – A local meaning\n"+
+		"ZZQX,Zebra Quotient – Only in this file\n")
+	second := writeDict(t, "second.yaml", "- acronym: ABG\n  full_form: Another Better Guess\n")
+
+	dict := newDictionary(t, tmdr.WithSource(first))
+	a, err := dict.Lookup(ctx, "abg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The source's senses rank ahead of the built-in ones and replace the
+	// built-in sense with the same full form
+	if got, want := fullForms(a), "Arterial Blood Gas, Air Bubble Gauge"; got != want {
+		t.Errorf("senses %q, want %q", got, want)
+	}
+	if s := a.Primary(); s.Definition != "Our own definition" || s.Source != first {
+		t.Errorf("primary sense %+v, want the definition from %s", s, first)
+	}
+	if _, err := dict.Lookup(ctx, "ZZQX"); err != nil {
+		t.Errorf("an acronym only in the source: %v", err)
+	}
+
+	// A later source ranks ahead of an earlier one
+	dict = newDictionary(t, tmdr.WithSource(first), tmdr.WithSource(second))
+	a, err = dict.Lookup(ctx, "ABG")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fullForms(a), "Another Better Guess, Arterial Blood Gas, Air Bubble Gauge"; got != want {
+		t.Errorf("senses %q, want %q", got, want)
+	}
+
+	dict = newDictionary(t, tmdr.WithSource(first), tmdr.WithoutEmbedded())
+	if _, err := dict.Lookup(ctx, "CBC"); !errors.Is(err, tmdr.ErrNotFound) {
+		t.Errorf("WithoutEmbedded still found CBC: %v", err)
+	}
+}
+
+func TestWithSpecialty(t *testing.T) {
+	ctx := context.Background()
+
+	dict := newDictionary(t, tmdr.WithSpecialty("cardiology"))
+	a, err := dict.Lookup(ctx, "MS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fullForms(a); got != "Mitral Stenosis" {
+		t.Errorf("MS in cardiology = %q, want only Mitral Stenosis", got)
+	}
+	if _, err := dict.Lookup(ctx, "AKI"); !errors.Is(err, tmdr.ErrNotFound) {
+		t.Errorf("AKI in cardiology = %v, want ErrNotFound", err)
+	}
+
+	// Aliases name the same specialty
+	dict = newDictionary(t, tmdr.WithSpecialty("renal"))
+	all, err := dict.All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range all {
+		for _, s := range a.Senses {
+			if s.Specialty != "nephrology" {
+				t.Errorf("%s (%s) is in %s, not nephrology", a.Acronym, s.FullForm, s.Specialty)
+			}
+		}
+	}
+	if len(all) == 0 {
+		t.Error("no acronyms in nephrology")
+	}
+
+	if _, err := tmdr.New(tmdr.WithSpecialty("astrology")); err == nil {
+		t.Error("New accepted an unknown specialty")
+	}
+}
+
+func TestWithScorer(t *testing.T) {
+	ctx := context.Background()
+
+	// Only BP resembles anything, and the query arrives as a key
+	var queries []string
+	onlyBP := tmdr.ScorerFunc(func(query, candidate string) float64 {
+		if candidate != "BP" {
+			return 0
+		}
+		queries = append(queries, query)
+		return 0.5
+	})
+	dict := newDictionary(t, tmdr.WithScorer(onlyBP))
+	matches, err := dict.Fuzzy(ctx, " ｂｐｐ", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 || matches[0].Acronym.Acronym != "BP" || matches[0].Score != 0.5 {
+		t.Errorf("Fuzzy = %+v, want only BP scoring 0.5", matches)
+	}
+	if len(queries) != 1 || queries[0] != "BPP" {
+		t.Errorf("scorer was asked about %q, want the key BPP once", queries)
+	}
+
+	for _, name := range tmdr.ScorerNames() {
+		scorer, err := tmdr.ScorerByName(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		matches, err := newDictionary(t, tmdr.WithScorer(scorer)).Fuzzy(ctx, "cbx", 3)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if !slices.ContainsFunc(matches, func(m tmdr.Match) bool { return m.Acronym.Acronym == "CBC" }) {
+			t.Errorf("%s suggested %+v for cbx, want CBC among them", name, matches)
+		}
+	}
+}
+
+// TestResultsAreCopies checks that changing a result doesn't change the
+// dictionary
+func TestResultsAreCopies(t *testing.T) {
+	ctx := context.Background()
+	dict := newDictionary(t)
+
+	a, err := dict.Lookup(ctx, "ABG")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Senses[0].FullForm = "Changed"
+	all, err := dict.All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	all[0].Senses[0].FullForm = "Changed"
+
+	a, err = dict.Lookup(ctx, "ABG")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Primary().FullForm != "Arterial Blood Gas" {
+		t.Errorf("ABG is now %q", a.Primary().FullForm)
+	}
+	if got, _ := dict.All(ctx); got[0].Senses[0].FullForm == "Changed" {
+		t.Error("changing All's result changed the dictionary")
+	}
+}
@@ -0,0 +1,158 @@
+package tmdr
+
+import (
+	"slices"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+)
+
+// Acronym is an acronym with every sense it can stand for, most common first
+type Acronym struct {
+	Acronym string
+	Senses  []Sense
+}
+
+// Primary returns the most common sense of the acronym
+func (a Acronym) Primary() Sense {
+	if len(a.Senses) == 0 {
+		return Sense{}
+	}
+	return a.Senses[0]
+}
+
+// Sense is one meaning of an acronym
+type Sense struct {
+	FullForm   string
+	Definition string
+	// Specialty is a canonical specialty name such as "cardiology"; see
+	// Specialties
+	Specialty  string
+	Synonyms   []string
+	References []string
+	// DoNotUse marks an abbreviation on a patient safety do-not-use list,
+	// which should not appear in clinical documents or user interfaces
+	DoNotUse bool
+	// Alternative is what to write instead of a do-not-use abbreviation
+	Alternative string
+	// Source names the dictionary the sense came from: "embedded" for the
+	// built-in dictionary, or a file path
+	Source string
+}
+
+// RankedSense is a sense with how strongly a piece of text points to it
+type RankedSense struct {
+	Sense
+	// Score is the sense's share of the evidence, from 0 to 1. Every score is
+	// 0 when nothing in the text points to any sense.
+	Score float64
+	// Cues are the words in the text that point to this sense
+	Cues []string
+}
+
+// Match is an acronym found by a fuzzy lookup or a search, with a score from
+// 0 to 1 where 1 is the best possible match
+type Match struct {
+	Acronym Acronym
+	Score   float64
+}
+
+// Entry is one sense of an acronym to add with WithEntries
+type Entry struct {
+	Acronym     string
+	FullForm    string
+	Definition  string
+	Specialty   string
+	Synonyms    []string
+	References  []string
+	DoNotUse    bool
+	Alternative string
+}
+
+// SearchOptions tunes Search
+type SearchOptions struct {
+	// Limit caps the number of results; 0 returns every match
+	Limit int
+	// Prefix also matches words that start with the last query word, for
+	// search-as-you-type
+	Prefix bool
+	// Fuzzy also matches words a typo or two away from a query word
+	Fuzzy bool
+}
+
+// ExpandStyle controls how Expand annotates acronyms
+type ExpandStyle string
+
+const (
+	// ExpandInline writes the full form after the first use: ABG (Arterial Blood Gas)
+	ExpandInline ExpandStyle = "inline"
+	// ExpandFootnote marks the first use with a Markdown footnote
+	ExpandFootnote ExpandStyle = "footnote"
+	// ExpandGlossary leaves the text alone and appends a glossary
+	ExpandGlossary ExpandStyle = "glossary"
+)
+
+// Specialty is a clinical specialty acronyms are grouped by
+type Specialty struct {
+	// Name is the canonical name used in Sense.Specialty, such as "admin"
+	Name string
+	// Label is the name to show, such as "Admin & Regulatory"
+	Label string
+}
+
+// Specialties lists the specialty taxonomy in display order
+func Specialties() []Specialty {
+	specialties := make([]Specialty, len(acronym.Specialties))
+	for i, s := range acronym.Specialties {
+		specialties[i] = Specialty{Name: s.Name, Label: s.Label}
+	}
+	return specialties
+}
+
+// The conversions below copy slices so callers can't modify the dictionary
+// through a result
+
+func fromAcronym(a acronym.Acronym) Acronym {
+	senses := make([]Sense, len(a.Senses))
+	for i, s := range a.Senses {
+		senses[i] = fromSense(s)
+	}
+	return Acronym{Acronym: a.Acronym, Senses: senses}
+}
+
+func fromSense(s acronym.Sense) Sense {
+	return Sense{
+		FullForm:    s.FullForm,
+		Definition:  s.Definition,
+		Specialty:   s.Specialty,
+		Synonyms:    slices.Clone(s.Synonyms),
+		References:  slices.Clone(s.References),
+		DoNotUse:    s.DoNotUse,
+		Alternative: s.Alternative,
+		Source:      s.Source,
+	}
+}
+
+func fromMatches(matches []acronym.Match) []Match {
+	converted := make([]Match, len(matches))
+	for i, m := range matches {
+		converted[i] = Match{Acronym: fromAcronym(m.Acronym), Score: m.Score}
+	}
+	return converted
+}
+
+func toEntries(entries []Entry) []acronym.Entry {
+	converted := make([]acronym.Entry, len(entries))
+	for i, e := range entries {
+		converted[i] = acronym.Entry{
+			Acronym:     e.Acronym,
+			FullForm:    e.FullForm,
+			Definition:  e.Definition,
+			Specialty:   e.Specialty,
+			Synonyms:    e.Synonyms,
+			References:  e.References,
+			DoNotUse:    e.DoNotUse,
+			Alternative: e.Alternative,
+		}
+	}
+	return converted
+}
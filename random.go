@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/output"
+)
+
+// runRandom prints a random acronym and returns the process exit code
+func runRandom(shared *sharedFlags, args []string) int {
+	fs := newFlagSet("random")
+	shared.addOutputFlags(fs)
+	shared.addSourceFlags(fs)
+	fs.Parse(args)
+
+	out, code := shared.printer()
+	if code != exitOK {
+		return code
+	}
+	repo, code := shared.load()
+	if code != exitOK {
+		return code
+	}
+
+	a, err := repo.Random()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting random acronym: %v\n", err)
+		return exitCode(err)
+	}
+	if out.structured() {
+		exitOnPrintError(out.print([]output.Result{
+			output.NewResult("", acronym.Match{Acronym: *a, Type: acronym.MatchRandom, Score: 1}),
+		}))
+	} else {
+		printAcronym(a)
+	}
+	return exitOK
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/output"
+)
+
+// reverseFields are the parts of an entry a reverse lookup matches against
+var reverseFields = []acronym.SearchField{acronym.FieldFullForm, acronym.FieldSynonyms}
+
+// runReverse finds the acronyms for a phrase and returns the process exit code
+func runReverse(shared *sharedFlags, args []string) int {
+	fs := newFlagSet("reverse")
+	limit := fs.Int("limit", 5, "Maximum number of results (0 for all)")
+	shared.addOutputFlags(fs)
+	shared.addSourceFlags(fs)
+	fs.Parse(args)
+
+	phrase := strings.Join(fs.Args(), " ")
+	if strings.TrimSpace(phrase) == "" {
+		fs.Usage()
+		return exitUsage
+	}
+
+	out, code := shared.printer()
+	if code != exitOK {
+		return code
+	}
+	repo, code := shared.load()
+	if code != exitOK {
+		return code
+	}
+
+	matches, err := repo.Search(phrase, acronym.SearchOptions{
+		Limit:  *limit,
+		Fields: reverseFields,
+		Fuzzy:  true,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitCode(err)
+	}
+
+	if out.structured() {
+		results := make([]output.Result, len(matches))
+		for i, match := range matches {
+			results[i] = output.NewResult(phrase, match)
+		}
+		exitOnPrintError(out.print(results))
+	} else {
+		printReverseResults(phrase, matches)
+	}
+
+	if len(matches) == 0 {
+		return exitNotFound
+	}
+	return exitOK
+}
+
+// printReverseResults lists the acronyms for a phrase with the full form that
+// matched and its score as a percentage
+func printReverseResults(phrase string, matches []acronym.Match) {
+	if len(matches) == 0 {
+		fmt.Printf("No acronym found for '%s'.\n", phrase)
+		return
+	}
+
+	width := 0
+	for _, match := range matches {
+		width = max(width, len(match.Acronym.Acronym))
+	}
+	for _, match := range matches {
+		fmt.Printf("%-*s → %s (%.0f%%)\n", width, match.Acronym.Acronym, bestFullForm(phrase, match.Acronym), match.Score*100)
+	}
+}
+
+// bestFullForm picks the sense whose full form shares the most words with
+// phrase, so "tmdr reverse mitral stenosis" shows MS → Mitral Stenosis rather
+// than its primary sense
+func bestFullForm(phrase string, a acronym.Acronym) string {
+	words := strings.Fields(strings.ToLower(phrase))
+	best, bestCount := a.Primary().FullForm, 0
+	for _, sense := range a.Senses {
+		count := 0
+		fullForm := strings.ToLower(sense.FullForm)
+		for _, word := range words {
+			if strings.Contains(fullForm, word) {
+				count++
+			}
+		}
+		if count > bestCount {
+			best, bestCount = sense.FullForm, count
+		}
+	}
+	return best
+}
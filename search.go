@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/anthonylangham/tmdr/internal/acronym"
+	"github.com/anthonylangham/tmdr/internal/output"
+)
+
+// runSearch ranks acronyms against free text and returns the process exit code
+func runSearch(shared *sharedFlags, args []string) int {
+	fs := newFlagSet("search")
+	limit := fs.Int("limit", 10, "Maximum number of results (0 for all)")
+	shared.addOutputFlags(fs)
+	shared.addSourceFlags(fs)
+	fs.Parse(args)
+
+	query := strings.Join(fs.Args(), " ")
+	if strings.TrimSpace(query) == "" {
+		fs.Usage()
+		return exitUsage
+	}
+
+	out, code := shared.printer()
+	if code != exitOK {
+		return code
+	}
+	repo, code := shared.load()
+	if code != exitOK {
+		return code
+	}
+
+	matches, err := repo.Search(query, acronym.SearchOptions{Limit: *limit})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitCode(err)
+	}
+
+	if out.structured() {
+		results := make([]output.Result, len(matches))
+		for i, match := range matches {
+			results[i] = output.NewResult(query, match)
+		}
+		exitOnPrintError(out.print(results))
+	} else {
+		printSearchResults(query, matches)
+	}
+
+	if len(matches) == 0 {
+		return exitNotFound
+	}
+	return exitOK
+}
+
+// printSearchResults lists matches best first, one acronym per line
+func printSearchResults(query string, matches []acronym.Match) {
+	if len(matches) == 0 {
+		fmt.Printf("No acronyms match '%s'.\n", query)
+		return
+	}
+
+	width := 0
+	for _, match := range matches {
+		width = max(width, len(match.Acronym.Acronym))
+	}
+	for _, match := range matches {
+		a := match.Acronym
+		fmt.Printf("%-*s → %s", width, a.Acronym, a.Primary().FullForm)
+		if len(a.Senses) > 1 {
+			fmt.Printf(" (+%d)", len(a.Senses)-1)
+		}
+		fmt.Println()
+	}
+}
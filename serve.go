@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/anthonylangham/tmdr/internal/server"
+)
+
+// runServe serves the dictionary as a JSON API until interrupted and returns
+// the process exit code
+func runServe(shared *sharedFlags, args []string) int {
+	fs := newFlagSet("serve")
+	addr := fs.String("addr", server.DefaultAddr, "Address to listen on")
+	cors := fs.String("cors", "", "Comma-separated origins browsers may call the API from, or * for any")
+	shared.addDictionaryFlags(fs)
+	fs.Parse(args)
+
+	repo, code := shared.load()
+	if code != exitOK {
+		return code
+	}
+
+	var opts server.Options
+	if *cors != "" {
+		for _, origin := range strings.Split(*cors, ",") {
+			opts.AllowOrigins = append(opts.AllowOrigins, strings.TrimSpace(origin))
+		}
+	}
+
+	// Stop gracefully on Ctrl-C or when a process manager asks
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Fprintf(os.Stderr, "tmdr API listening on http://%s\n", *addr)
+	if err := server.New(repo, opts).ListenAndServe(ctx, *addr); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitFailure
+	}
+	return exitOK
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/anthonylangham/tmdr/internal/update"
+	"github.com/anthonylangham/tmdr/internal/version"
+)
+
+// runUpdate replaces the running binary with the latest release and returns
+// the process exit code
+func runUpdate(_ *sharedFlags, args []string) int {
+	fs := newFlagSet("update")
+	checkOnly := fs.Bool("check", false, "Only report whether a newer version is available")
+	fs.Parse(args)
+
+	info, err := update.Check()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitFailure
+	}
+	if !info.Available {
+		fmt.Printf("tmdr %s is the latest version\n", version.Version)
+		return exitOK
+	}
+
+	fmt.Printf("tmdr %s is available (you have %s)\n", info.Version, version.Version)
+	if *checkOnly {
+		fmt.Println(info.URL)
+		return exitOK
+	}
+	if info.DownloadURL == "" {
+		fmt.Fprintf(os.Stderr, "No %s download in this release; see %s\n", info.AssetName, info.URL)
+		return exitFailure
+	}
+
+	path, err := update.DownloadUpdate(info.DownloadURL, func(downloaded, total int64) {
+		if total > 0 {
+			fmt.Fprintf(os.Stderr, "\rDownloading... %d%%", downloaded*100/total)
+		}
+	})
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error downloading update: %v\n", err)
+		return exitFailure
+	}
+	if err := update.InstallUpdate(path); err != nil {
+		fmt.Fprintf(os.Stderr, "Error installing update: %v\n", err)
+		return exitFailure
+	}
+
+	fmt.Printf("Updated to tmdr %s\n", info.Version)
+	return exitOK
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/anthonylangham/tmdr/internal/version"
+)
+
+// runVersion prints the version and returns the process exit code
+func runVersion(_ *sharedFlags, args []string) int {
+	fs := newFlagSet("version")
+	fs.Parse(args)
+
+	fmt.Printf("tmdr version %s\n", version.Version)
+	return exitOK
+}